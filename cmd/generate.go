@@ -0,0 +1,99 @@
+/*
+Copyright © 2020 Romber Li <romber2001@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/config"
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/romberli/sql-parser-go/pkg/message"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const generatedFileMode = 0644
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "generate command",
+	Long:  `use generate to generate the go source code of the finite automata`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// if no subcommand is set, it will print help information.
+		err := cmd.Help()
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrPrintHelpInfo, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+	},
+}
+
+// generateLexerCmd represents the generate lexer command
+var generateLexerCmd = &cobra.Command{
+	Use:   "lexer",
+	Short: "generate lexer command",
+	Long:  `use generate lexer to serialize the dfa of the lexer into a go source file with a static transition table and a Match function`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// init config
+		err := initConfig()
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrInitConfig, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+
+		cs := lexer.NewCharacterSetWithDefault()
+		dfa := lexer.NewDFAWithNFA(lexer.NewNFAWithANSIQuotes(cs, viper.GetBool(config.GenerateLexerANSIQuotesKey)))
+		if viper.GetBool(config.GenerateLexerMinimizeKey) {
+			minimizeDFA(dfa)
+		}
+		td := lexer.NewTableDFA(dfa)
+
+		output := viper.GetString(config.GenerateLexerOutputKey)
+		// generate the whole source code before writing, so that the output file will not be left incomplete
+		var buffer bytes.Buffer
+		err = td.Generate(&buffer, viper.GetString(config.GenerateLexerPackageKey))
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrGenerateLexer, output, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+		err = os.WriteFile(output, buffer.Bytes(), generatedFileMode)
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrGenerateLexer, output, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+
+		fmt.Println(message.NewMessage(message.InfoLexerGenerated, td.StateCount, td.ClassCount, output).String())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(generateCmd)
+	generateCmd.AddCommand(generateLexerCmd)
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// generateLexerCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	generateLexerCmd.Flags().StringVar(&generateLexerMinimize, "minimize", constant.DefaultRandomString, fmt.Sprintf("specify whether to minimize the dfa before generating(default: %t)", config.DefaultGenerateLexerMinimize))
+	generateLexerCmd.Flags().StringVar(&generateLexerANSIQuotes, "ansi-quotes", constant.DefaultRandomString, fmt.Sprintf("specify whether to treat the double-quoted text as a quoted identifier like the ANSI_QUOTES sql mode(default: %t)", config.DefaultGenerateLexerANSIQuotes))
+	generateLexerCmd.Flags().StringVar(&generateLexerPackage, "package", constant.DefaultRandomString, fmt.Sprintf("specify the package name of the generated go source code(default: %s)", config.DefaultGenerateLexerPackage))
+	generateLexerCmd.Flags().StringVar(&generateLexerOutput, "output", constant.DefaultRandomString, fmt.Sprintf("specify the file path of the generated go source code(default: %s)", config.DefaultGenerateLexerOutput))
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2020 Romber Li <romber2001@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/config"
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/romberli/sql-parser-go/pkg/message"
+	"github.com/romberli/sql-parser-go/pkg/parser"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "graph command",
+	Long:  `use graph to write the finite automata in the DOT language of Graphviz, e.g. sql-parser-go graph | dot -Tsvg -o nfa.svg`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// init config
+		err := initConfig()
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrInitConfig, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+
+		var tokenTypes []token.Type
+		for _, name := range config.GetTokenTypeNames(viper.GetString(config.GraphTokenTypesKey)) {
+			tokenTypes = append(tokenTypes, token.LookupTypes(name)...)
+		}
+
+		var buffer bytes.Buffer
+		cs := lexer.NewCharacterSetWithDefault()
+		ansiQuotes := viper.GetBool(config.GraphANSIQuotesKey)
+		automaton := viper.GetString(config.GraphAutomatonKey)
+		switch automaton {
+		case config.NFA:
+			err = lexer.NewNFAWithANSIQuotes(cs, ansiQuotes).WriteDOT(&buffer, tokenTypes...)
+		case config.DFA:
+			dfa := lexer.NewDFAWithNFA(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes))
+			if viper.GetBool(config.GraphMinimizeKey) {
+				// the standard output may be the graph, so the minimizing message is not printed
+				dfa.Minimize()
+			}
+			err = dfa.WriteDOT(&buffer, tokenTypes...)
+		case config.ParserNFA:
+			err = parser.NewNFA(nil).WriteDOT(&buffer, tokenTypes...)
+		default:
+			fmt.Println(message.NewMessage(message.ErrNotValidGraphAutomaton, automaton).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+
+		output := viper.GetString(config.GraphOutputKey)
+		if err == nil {
+			err = writeGraph(output, buffer.Bytes())
+		}
+		if err != nil {
+			fmt.Println(message.NewMessage(message.ErrWriteGraph, output, err.Error()).Error())
+			os.Exit(constant.DefaultAbnormalExitCode)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// graphCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	graphCmd.Flags().StringVar(&graphAutomaton, "automaton", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s, %s]. default: %s)", config.NFA, config.DFA, config.ParserNFA, config.DefaultGraphAutomaton))
+	graphCmd.Flags().StringVar(&graphMinimize, "minimize", constant.DefaultRandomString, fmt.Sprintf("specify whether to minimize the dfa(default: %t)", config.DefaultGraphMinimize))
+	graphCmd.Flags().StringVar(&graphANSIQuotes, "ansi-quotes", constant.DefaultRandomString, fmt.Sprintf("specify whether to treat the double-quoted text as a quoted identifier like the ANSI_QUOTES sql mode(default: %t)", config.DefaultGraphANSIQuotes))
+	graphCmd.Flags().StringVar(&graphTokenTypes, "token-types", constant.DefaultRandomString, fmt.Sprintf("specify the names of the token types separated by commas to filter the graph, e.g. selectKeyword,identifier(default: %s)", config.DefaultGraphTokenTypes))
+	graphCmd.Flags().StringVar(&graphOutput, "output", constant.DefaultRandomString, "specify the file path of the graph(default: the standard output)")
+}
+
+// writeGraph writes the graph to the output file, it writes to the standard output if the output is empty
+func writeGraph(output string, graph []byte) error {
+	if output == constant.EmptyString {
+		_, err := io.Copy(os.Stdout, bytes.NewReader(graph))
+		return err
+	}
+
+	return os.WriteFile(output, graph, generatedFileMode)
+}
@@ -43,21 +43,34 @@ var lexCmd = &cobra.Command{
 		var l *lexer.Lexer
 
 		fa := viper.GetString(config.LexFiniteAutomataKey)
+		cs := lexer.NewCharacterSetWithDefault()
+		ansiQuotes := viper.GetBool(config.LexANSIQuotesKey)
 		switch fa {
 		case config.NFA:
-			l = lexer.NewLexer(lexer.NewNFAWithDefault())
+			l = lexer.NewLexer(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes))
 		case config.DFA:
-			l = lexer.NewLexer(lexer.NewDFAWithDefault())
+			dfa := lexer.NewDFAWithNFA(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes))
+			if viper.GetBool(config.LexMinimizeKey) {
+				minimizeDFA(dfa)
+			}
+			l = lexer.NewLexer(dfa)
+		case config.LazyDFA:
+			l = lexer.NewLexer(lexer.NewLazyDFA(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes), viper.GetInt(config.LexMaxStateCountKey)))
 		default:
 			fmt.Println(message.NewMessage(message.ErrNotValidLexFiniteAutomata, viper.GetString(config.LexFiniteAutomataKey)).Error())
 			os.Exit(constant.DefaultAbnormalExitCode)
 		}
 
-		tokens := l.Lex(viper.GetString(config.SQLKey))
+		l.SetSkipComments(viper.GetBool(config.LexSkipCommentsKey))
+		l.SetServerVersion(viper.GetInt(config.LexServerVersionKey))
+		tokens, diagnostics := l.LexWithDiagnostics(viper.GetString(config.SQLKey))
 
 		for _, token := range tokens {
 			fmt.Println(token.String())
 		}
+		for _, diagnostic := range diagnostics {
+			fmt.Println(diagnostic.String())
+		}
 	},
 }
 
@@ -74,5 +87,17 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// lexCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
-	lexCmd.Flags().StringVar(&lexFiniteAutomata, "finite-automata", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s]. default: %s)", config.NFA, config.DFA, config.DefaultLexFiniteAutomata))
+	lexCmd.Flags().StringVar(&lexFiniteAutomata, "finite-automata", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s, %s]. default: %s)", config.NFA, config.DFA, config.LazyDFA, config.DefaultLexFiniteAutomata))
+	lexCmd.Flags().StringVar(&lexMinimize, "minimize", constant.DefaultRandomString, fmt.Sprintf("specify whether to minimize the dfa(default: %t)", config.DefaultLexMinimize))
+	lexCmd.Flags().StringVar(&lexSkipComments, "skip-comments", constant.DefaultRandomString, fmt.Sprintf("specify whether to skip the comments(default: %t)", config.DefaultLexSkipComments))
+	lexCmd.Flags().IntVar(&lexServerVersion, "server-version", constant.DefaultRandomInt, fmt.Sprintf("specify the mysql server version that the executable comments are executed on(default: %d)", config.DefaultLexServerVersion))
+	lexCmd.Flags().IntVar(&lexMaxStateCount, "max-state-count", constant.DefaultRandomInt, fmt.Sprintf("specify the max number of the cached sets of the lazy dfa(default: %d)", config.DefaultLexMaxStateCount))
+	lexCmd.Flags().StringVar(&lexANSIQuotes, "ansi-quotes", constant.DefaultRandomString, fmt.Sprintf("specify whether to treat the double-quoted text as a quoted identifier like the ANSI_QUOTES sql mode(default: %t)", config.DefaultLexANSIQuotes))
+}
+
+// minimizeDFA minimizes the dfa and prints the set count before and after minimizing
+func minimizeDFA(dfa *lexer.DFA) {
+	before := dfa.GetSetCount()
+	dfa.Minimize()
+	fmt.Println(message.NewMessage(message.InfoDFAMinimized, before, dfa.GetSetCount()).String())
 }
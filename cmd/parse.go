@@ -32,7 +32,7 @@ import (
 var parseCmd = &cobra.Command{
 	Use:   "parse",
 	Short: "parse command",
-	Long:  `use parse to match tokens`,
+	Long:  `use parse to match tokens, the sql could be a script of several statements separated by ; or the delimiter set by the DELIMITER command`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// init config
 		err := initConfig()
@@ -43,38 +43,51 @@ var parseCmd = &cobra.Command{
 
 		var l *lexer.Lexer
 		lexerFA := viper.GetString(config.ParseLexerFiniteAutomataKey)
+		cs := lexer.NewCharacterSetWithDefault()
+		ansiQuotes := viper.GetBool(config.ParseLexerANSIQuotesKey)
 		switch lexerFA {
 		case config.NFA:
-			l = lexer.NewLexer(lexer.NewNFAWithDefault())
+			l = lexer.NewLexer(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes))
 		case config.DFA:
-			l = lexer.NewLexer(lexer.NewDFAWithDefault())
+			dfa := lexer.NewDFAWithNFA(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes))
+			if viper.GetBool(config.ParseLexerMinimizeKey) {
+				minimizeDFA(dfa)
+			}
+			l = lexer.NewLexer(dfa)
+		case config.LazyDFA:
+			l = lexer.NewLexer(lexer.NewLazyDFA(lexer.NewNFAWithANSIQuotes(cs, ansiQuotes), viper.GetInt(config.ParseLexerMaxStateCountKey)))
 		default:
 			fmt.Println(message.NewMessage(message.ErrNotValidParseLexerFiniteAutomata, viper.GetString(config.ParseLexerFiniteAutomataKey)).Error())
 			os.Exit(constant.DefaultAbnormalExitCode)
 		}
 
-		tokens := l.Lex(sql)
-
-		var p *parser.Parser
+		// the comments are always skipped while parsing
+		l.SetServerVersion(viper.GetInt(config.ParseLexerServerVersionKey))
+		var newParser parser.NewParserFunc
 
 		parserFA := viper.GetString(config.ParseParserFiniteAutomataKey)
 		switch parserFA {
 		case config.NFA:
-			p = parser.NewParser(parser.NewNFA(tokens))
+			newParser = parser.NewNFAParser
 		case config.LL:
-			p = parser.NewParser(parser.NewLLOne(tokens))
+			newParser = parser.NewLLOneParser
 		default:
 			fmt.Println(message.NewMessage(message.ErrNotValidParseParserFiniteAutomata, viper.GetString(config.ParseParserFiniteAutomataKey)).Error())
 			os.Exit(constant.DefaultAbnormalExitCode)
 		}
 
-		astNode, err := p.Parse()
+		// the sql may be a script of several statements, each statement is scanned with a streaming scanner,
+		// and the tokens will be read by the parser directly
+		statements, err := parser.ParseScript(l, viper.GetString(config.SQLKey), newParser)
 		if err != nil {
 			fmt.Println(err.Error())
 			os.Exit(constant.DefaultAbnormalExitCode)
 		}
 
-		astNode.PrintChildren()
+		for _, statement := range statements {
+			fmt.Println(fmt.Sprintf("statement: %s, span: %s", statement.Text, statement.Span.String()))
+			statement.Node.PrintChildren()
+		}
 	},
 }
 
@@ -91,6 +104,10 @@ func init() {
 	// is called directly, e.g.:
 	// parseCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	// finite automata
-	parseCmd.Flags().StringVar(&parseLexerFiniteAutomata, "lexer-finite-automata", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s]. default: %s)", config.NFA, config.DFA, config.DefaultParseLexerFiniteAutomata))
+	parseCmd.Flags().StringVar(&parseLexerFiniteAutomata, "lexer-finite-automata", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s, %s]. default: %s)", config.NFA, config.DFA, config.LazyDFA, config.DefaultParseLexerFiniteAutomata))
+	parseCmd.Flags().StringVar(&parseLexerMinimize, "lexer-minimize", constant.DefaultRandomString, fmt.Sprintf("specify whether to minimize the dfa of the lexer(default: %t)", config.DefaultParseLexerMinimize))
+	parseCmd.Flags().IntVar(&parseLexerServerVersion, "lexer-server-version", constant.DefaultRandomInt, fmt.Sprintf("specify the mysql server version that the executable comments are executed on(default: %d)", config.DefaultParseLexerServerVersion))
+	parseCmd.Flags().IntVar(&parseLexerMaxStateCount, "lexer-max-state-count", constant.DefaultRandomInt, fmt.Sprintf("specify the max number of the cached sets of the lazy dfa of the lexer(default: %d)", config.DefaultParseLexerMaxStateCount))
+	parseCmd.Flags().StringVar(&parseLexerANSIQuotes, "lexer-ansi-quotes", constant.DefaultRandomString, fmt.Sprintf("specify whether the lexer treats the double-quoted text as a quoted identifier like the ANSI_QUOTES sql mode(default: %t)", config.DefaultParseLexerANSIQuotes))
 	parseCmd.Flags().StringVar(&parseParserFiniteAutomata, "parser-finite-automata", constant.DefaultRandomString, fmt.Sprintf("specify the finite automata(available: [%s, %s]. default: %s)", config.NFA, config.LL, config.DefaultParseParserFiniteAutomata))
 }
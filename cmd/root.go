@@ -44,9 +44,29 @@ var (
 	logMaxBackups int
 	// lex
 	lexFiniteAutomata string
+	lexMinimize       string
+	lexANSIQuotes     string
+	lexSkipComments   string
+	lexServerVersion  int
+	lexMaxStateCount  int
 	// parse
 	parseLexerFiniteAutomata  string
+	parseLexerMinimize        string
+	parseLexerANSIQuotes      string
+	parseLexerServerVersion   int
+	parseLexerMaxStateCount   int
 	parseParserFiniteAutomata string
+	// generate
+	generateLexerMinimize   string
+	generateLexerANSIQuotes string
+	generateLexerPackage    string
+	generateLexerOutput     string
+	// graph
+	graphAutomaton  string
+	graphMinimize   string
+	graphANSIQuotes string
+	graphTokenTypes string
+	graphOutput     string
 	// sql
 	sql string
 )
@@ -103,7 +123,7 @@ func init() {
 	rootCmd.PersistentFlags().IntVar(&logMaxDays, "log-max-days", constant.DefaultRandomInt, fmt.Sprintf("specify the log file max days(default: %d)", log.DefaultLogMaxDays))
 	rootCmd.PersistentFlags().IntVar(&logMaxBackups, "log-max-backups", constant.DefaultRandomInt, fmt.Sprintf("specify the log file max backups(default: %d)", log.DefaultLogMaxBackups))
 	// sql
-	rootCmd.PersistentFlags().StringVar(&sql, "sql", constant.DefaultRandomString, fmt.Sprintf("specify the log format(default: %s)", constant.EmptyString))
+	rootCmd.PersistentFlags().StringVar(&sql, "sql", constant.DefaultRandomString, fmt.Sprintf("specify the sql, the parse command also accepts a script of several statements(default: %s)", constant.EmptyString))
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -226,15 +246,73 @@ func OverrideConfig() (err error) {
 	if lexFiniteAutomata != constant.DefaultRandomString {
 		viper.Set(config.LexFiniteAutomataKey, lexFiniteAutomata)
 	}
+	if lexMinimize != constant.DefaultRandomString {
+		viper.Set(config.LexMinimizeKey, lexMinimize)
+	}
+	if lexANSIQuotes != constant.DefaultRandomString {
+		viper.Set(config.LexANSIQuotesKey, lexANSIQuotes)
+	}
+	if lexSkipComments != constant.DefaultRandomString {
+		viper.Set(config.LexSkipCommentsKey, lexSkipComments)
+	}
+	if lexServerVersion != constant.DefaultRandomInt {
+		viper.Set(config.LexServerVersionKey, lexServerVersion)
+	}
+	if lexMaxStateCount != constant.DefaultRandomInt {
+		viper.Set(config.LexMaxStateCountKey, lexMaxStateCount)
+	}
 
 	// override parse
 	if parseLexerFiniteAutomata != constant.DefaultRandomString {
 		viper.Set(config.ParseLexerFiniteAutomataKey, parseLexerFiniteAutomata)
 	}
+	if parseLexerMinimize != constant.DefaultRandomString {
+		viper.Set(config.ParseLexerMinimizeKey, parseLexerMinimize)
+	}
+	if parseLexerANSIQuotes != constant.DefaultRandomString {
+		viper.Set(config.ParseLexerANSIQuotesKey, parseLexerANSIQuotes)
+	}
+	if parseLexerServerVersion != constant.DefaultRandomInt {
+		viper.Set(config.ParseLexerServerVersionKey, parseLexerServerVersion)
+	}
+	if parseLexerMaxStateCount != constant.DefaultRandomInt {
+		viper.Set(config.ParseLexerMaxStateCountKey, parseLexerMaxStateCount)
+	}
 	if parseParserFiniteAutomata != constant.DefaultRandomString {
 		viper.Set(config.ParseParserFiniteAutomataKey, parseParserFiniteAutomata)
 	}
 
+	// override generate
+	if generateLexerMinimize != constant.DefaultRandomString {
+		viper.Set(config.GenerateLexerMinimizeKey, generateLexerMinimize)
+	}
+	if generateLexerANSIQuotes != constant.DefaultRandomString {
+		viper.Set(config.GenerateLexerANSIQuotesKey, generateLexerANSIQuotes)
+	}
+	if generateLexerPackage != constant.DefaultRandomString {
+		viper.Set(config.GenerateLexerPackageKey, generateLexerPackage)
+	}
+	if generateLexerOutput != constant.DefaultRandomString {
+		viper.Set(config.GenerateLexerOutputKey, generateLexerOutput)
+	}
+
+	// override graph
+	if graphAutomaton != constant.DefaultRandomString {
+		viper.Set(config.GraphAutomatonKey, graphAutomaton)
+	}
+	if graphMinimize != constant.DefaultRandomString {
+		viper.Set(config.GraphMinimizeKey, graphMinimize)
+	}
+	if graphANSIQuotes != constant.DefaultRandomString {
+		viper.Set(config.GraphANSIQuotesKey, graphANSIQuotes)
+	}
+	if graphTokenTypes != constant.DefaultRandomString {
+		viper.Set(config.GraphTokenTypesKey, graphTokenTypes)
+	}
+	if graphOutput != constant.DefaultRandomString {
+		viper.Set(config.GraphOutputKey, graphOutput)
+	}
+
 	// override sql
 	if sql != constant.DefaultRandomString {
 		viper.Set(config.SQLKey, sql)
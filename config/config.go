@@ -18,6 +18,7 @@ package config
 
 import (
 	"fmt"
+	gotoken "go/token"
 	"path/filepath"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/romberli/go-util/constant"
 	"github.com/romberli/log"
 	"github.com/romberli/sql-parser-go/pkg/message"
+	"github.com/romberli/sql-parser-go/pkg/token"
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 )
@@ -34,8 +36,9 @@ import (
 var (
 	ValidLogLevels                 = []string{"debug", "info", "warn", "warning", "error", "fatal"}
 	ValidLogFormats                = []string{"text", "json"}
-	ValidLexFiniteAutomata         = []string{NFA, DFA}
+	ValidLexFiniteAutomata         = []string{NFA, DFA, LazyDFA}
 	ValidParseParserFiniteAutomata = []string{NFA, LL}
+	ValidGraphAutomata             = []string{NFA, DFA, ParserNFA}
 )
 
 // SetDefaultConfig set default configuration, it is the lowest priority
@@ -50,9 +53,29 @@ func SetDefaultConfig(baseDir string) {
 	viper.SetDefault(LogMaxBackupsKey, log.DefaultLogMaxBackups)
 	// lex
 	viper.SetDefault(LexFiniteAutomataKey, DefaultLexFiniteAutomata)
+	viper.SetDefault(LexMinimizeKey, DefaultLexMinimize)
+	viper.SetDefault(LexANSIQuotesKey, DefaultLexANSIQuotes)
+	viper.SetDefault(LexSkipCommentsKey, DefaultLexSkipComments)
+	viper.SetDefault(LexServerVersionKey, DefaultLexServerVersion)
+	viper.SetDefault(LexMaxStateCountKey, DefaultLexMaxStateCount)
 	// parse
 	viper.SetDefault(ParseLexerFiniteAutomataKey, DefaultParseLexerFiniteAutomata)
+	viper.SetDefault(ParseLexerMinimizeKey, DefaultParseLexerMinimize)
+	viper.SetDefault(ParseLexerANSIQuotesKey, DefaultParseLexerANSIQuotes)
+	viper.SetDefault(ParseLexerServerVersionKey, DefaultParseLexerServerVersion)
+	viper.SetDefault(ParseLexerMaxStateCountKey, DefaultParseLexerMaxStateCount)
 	viper.SetDefault(ParseParserFiniteAutomataKey, DefaultParseParserFiniteAutomata)
+	// generate
+	viper.SetDefault(GenerateLexerMinimizeKey, DefaultGenerateLexerMinimize)
+	viper.SetDefault(GenerateLexerANSIQuotesKey, DefaultGenerateLexerANSIQuotes)
+	viper.SetDefault(GenerateLexerPackageKey, DefaultGenerateLexerPackage)
+	viper.SetDefault(GenerateLexerOutputKey, DefaultGenerateLexerOutput)
+	// graph
+	viper.SetDefault(GraphAutomatonKey, DefaultGraphAutomaton)
+	viper.SetDefault(GraphMinimizeKey, DefaultGraphMinimize)
+	viper.SetDefault(GraphANSIQuotesKey, DefaultGraphANSIQuotes)
+	viper.SetDefault(GraphTokenTypesKey, DefaultGraphTokenTypes)
+	viper.SetDefault(GraphOutputKey, DefaultGraphOutput)
 }
 
 // ValidateConfig validates if the configuration is valid
@@ -77,6 +100,18 @@ func ValidateConfig() (err error) {
 		merr = multierror.Append(merr, err)
 	}
 
+	// validate generate
+	err = ValidateGenerate()
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+
+	// validate graph
+	err = ValidateGraph()
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+
 	// validate sql
 	err = ValidateSQL()
 	if err != nil {
@@ -180,10 +215,40 @@ func ValidateLex() error {
 		if err != nil {
 			merr = multierror.Append(merr, err)
 		} else if !valid {
-			merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidLexFiniteAutomata, valid))
+			merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidLexFiniteAutomata, fa))
 		}
 	}
 
+	// validate lex.minimize
+	_, err = cast.ToBoolE(viper.Get(LexMinimizeKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate lex.ansiQuotes
+	_, err = cast.ToBoolE(viper.Get(LexANSIQuotesKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate lex.skipComments
+	_, err = cast.ToBoolE(viper.Get(LexSkipCommentsKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate lex.serverVersion
+	serverVersion, err := cast.ToIntE(viper.Get(LexServerVersionKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if serverVersion < constant.ZeroInt {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidServerVersion, serverVersion))
+	}
+	// validate lex.maxStateCount
+	maxStateCount, err := cast.ToIntE(viper.Get(LexMaxStateCountKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if maxStateCount <= constant.ZeroInt {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidMaxStateCount, maxStateCount))
+	}
+
 	return merr.ErrorOrNil()
 }
 
@@ -205,6 +270,31 @@ func ValidateParse() error {
 		}
 	}
 
+	// validate parse.lexer.minimize
+	_, err = cast.ToBoolE(viper.Get(ParseLexerMinimizeKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate parse.lexer.ansiQuotes
+	_, err = cast.ToBoolE(viper.Get(ParseLexerANSIQuotesKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate parse.lexer.serverVersion
+	serverVersion, err := cast.ToIntE(viper.Get(ParseLexerServerVersionKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if serverVersion < constant.ZeroInt {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidServerVersion, serverVersion))
+	}
+	// validate parse.lexer.maxStateCount
+	maxStateCount, err := cast.ToIntE(viper.Get(ParseLexerMaxStateCountKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if maxStateCount <= constant.ZeroInt {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidMaxStateCount, maxStateCount))
+	}
+
 	// validate parse.parserFiniteAutomata
 	parserFA, err := cast.ToStringE(viper.Get(ParseParserFiniteAutomataKey))
 	if err != nil {
@@ -221,6 +311,84 @@ func ValidateParse() error {
 	return merr.ErrorOrNil()
 }
 
+func ValidateGenerate() error {
+	merr := &multierror.Error{}
+
+	// validate generate.lexer.minimize
+	_, err := cast.ToBoolE(viper.Get(GenerateLexerMinimizeKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate generate.lexer.ansiQuotes
+	_, err = cast.ToBoolE(viper.Get(GenerateLexerANSIQuotesKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate generate.lexer.package
+	packageName, err := cast.ToStringE(viper.Get(GenerateLexerPackageKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if !gotoken.IsIdentifier(packageName) {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidGenerateLexerPackage, packageName))
+	}
+	// validate generate.lexer.output
+	output, err := cast.ToStringE(viper.Get(GenerateLexerOutputKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else if strings.TrimSpace(output) == constant.EmptyString {
+		merr = multierror.Append(merr, message.NewMessage(message.ErrEmptyGenerateLexerOutput))
+	}
+
+	return merr.ErrorOrNil()
+}
+
+func ValidateGraph() error {
+	var valid bool
+
+	merr := &multierror.Error{}
+
+	// validate graph.automaton
+	automaton, err := cast.ToStringE(viper.Get(GraphAutomatonKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else {
+		valid, err = common.ElementInSlice(ValidGraphAutomata, automaton)
+		if err != nil {
+			merr = multierror.Append(merr, err)
+		} else if !valid {
+			merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidGraphAutomaton, automaton))
+		}
+	}
+	// validate graph.minimize
+	_, err = cast.ToBoolE(viper.Get(GraphMinimizeKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate graph.ansiQuotes
+	_, err = cast.ToBoolE(viper.Get(GraphANSIQuotesKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	// validate graph.tokenTypes
+	tokenTypes, err := cast.ToStringE(viper.Get(GraphTokenTypesKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	} else {
+		for _, name := range GetTokenTypeNames(tokenTypes) {
+			if len(token.LookupTypes(name)) == constant.ZeroInt {
+				merr = multierror.Append(merr, message.NewMessage(message.ErrNotValidGraphTokenType, name))
+			}
+		}
+	}
+	// validate graph.output
+	_, err = cast.ToStringE(viper.Get(GraphOutputKey))
+	if err != nil {
+		merr = multierror.Append(merr, err)
+	}
+
+	return merr.ErrorOrNil()
+}
+
 func ValidateSQL() error {
 	merr := &multierror.Error{}
 
@@ -232,6 +400,20 @@ func ValidateSQL() error {
 	return merr.ErrorOrNil()
 }
 
+// GetTokenTypeNames returns the token type names which are separated by commas, the empty names are ignored
+func GetTokenTypeNames(tokenTypes string) []string {
+	var names []string
+
+	for _, name := range strings.Split(tokenTypes, constant.CommaString) {
+		name = strings.TrimSpace(name)
+		if name != constant.EmptyString {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
 // TrimSpaceOfArg trims spaces of given argument
 func TrimSpaceOfArg(arg string) string {
 	args := strings.SplitN(arg, constant.EqualString, 2)
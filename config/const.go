@@ -35,10 +35,30 @@ const (
 	MaxLogMaxBackups                 = constant.MaxInt
 	NFA                              = "nfa"
 	DFA                              = "dfa"
+	LazyDFA                          = "lazy-dfa"
 	LL                               = "ll"
+	ParserNFA                        = "parser-nfa"
 	DefaultLexFiniteAutomata         = NFA
 	DefaultParseLexerFiniteAutomata  = NFA
 	DefaultParseParserFiniteAutomata = LL
+	DefaultLexMinimize               = false
+	DefaultLexANSIQuotes             = false
+	DefaultLexSkipComments           = true
+	DefaultLexServerVersion          = 80040
+	DefaultLexMaxStateCount          = 10000
+	DefaultParseLexerMinimize        = false
+	DefaultParseLexerANSIQuotes      = false
+	DefaultParseLexerServerVersion   = 80040
+	DefaultParseLexerMaxStateCount   = 10000
+	DefaultGenerateLexerMinimize     = true
+	DefaultGenerateLexerANSIQuotes   = false
+	DefaultGenerateLexerPackage      = "static"
+	DefaultGenerateLexerOutput       = "dfa.go"
+	DefaultGraphAutomaton            = NFA
+	DefaultGraphMinimize             = false
+	DefaultGraphANSIQuotes           = false
+	DefaultGraphTokenTypes           = constant.EmptyString
+	DefaultGraphOutput               = constant.EmptyString
 )
 
 // configuration constant
@@ -51,7 +71,25 @@ const (
 	LogMaxDaysKey                = "log.maxDays"
 	LogMaxBackupsKey             = "log.maxBackups"
 	LexFiniteAutomataKey         = "lex.finiteAutomata"
+	LexMinimizeKey               = "lex.minimize"
+	LexANSIQuotesKey             = "lex.ansiQuotes"
+	LexSkipCommentsKey           = "lex.skipComments"
+	LexServerVersionKey          = "lex.serverVersion"
+	LexMaxStateCountKey          = "lex.maxStateCount"
 	ParseLexerFiniteAutomataKey  = "parse.Lexer.finiteAutomata"
+	ParseLexerMinimizeKey        = "parse.lexer.minimize"
+	ParseLexerANSIQuotesKey      = "parse.lexer.ansiQuotes"
+	ParseLexerServerVersionKey   = "parse.lexer.serverVersion"
+	ParseLexerMaxStateCountKey   = "parse.lexer.maxStateCount"
 	ParseParserFiniteAutomataKey = "parse.parser.finiteAutomata"
+	GenerateLexerMinimizeKey     = "generate.lexer.minimize"
+	GenerateLexerANSIQuotesKey   = "generate.lexer.ansiQuotes"
+	GenerateLexerPackageKey      = "generate.lexer.package"
+	GenerateLexerOutputKey       = "generate.lexer.output"
+	GraphAutomatonKey            = "graph.automaton"
+	GraphMinimizeKey             = "graph.minimize"
+	GraphANSIQuotesKey           = "graph.ansiQuotes"
+	GraphTokenTypesKey           = "graph.tokenTypes"
+	GraphOutputKey               = "graph.output"
 	SQLKey                       = "sql"
 )
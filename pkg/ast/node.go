@@ -11,6 +11,7 @@ import (
 type Node struct {
 	Type  Type
 	Token *token.Token
+	Span  token.Span
 	Min   int
 	Max   int
 
@@ -104,20 +105,21 @@ func (n *Node) GetChildren() [][]*Node {
 			{NewNode(MinusOperator, 1, 1)},
 		}
 	case LiteralExpression:
+		// the operators after a literal are matched by OtherExpression,
+		// so that the ll(1) parser does not need to look ahead to tell a literal from a column name after the operator
 		return [][]*Node{{
 			NewNode(Literal, 1, 1),
-			NewNode(OtherLiteral, 0, -1),
 		}}
 	case Literal:
+		// the placeholders and the variables could be used wherever a literal is allowed
 		return [][]*Node{
 			{NewNode(NumberLiteral, 1, 1)},
 			{NewNode(StringLiteral, 1, 1)},
+			{NewNode(PositionalPlaceholder, 1, 1)},
+			{NewNode(NamedPlaceholder, 1, 1)},
+			{NewNode(UserVariable, 1, 1)},
+			{NewNode(SystemVariable, 1, 1)},
 		}
-	case OtherLiteral:
-		return [][]*Node{{
-			NewNode(ExpressionOperator, 1, 1),
-			NewNode(Literal, 1, 1),
-		}}
 	case AliasName:
 		return [][]*Node{
 			{
@@ -141,13 +143,13 @@ func (n *Node) GetChildren() [][]*Node {
 		}}
 	case ColumnComparison:
 		return [][]*Node{{
-			NewNode(ColumnName, 1, 1),
+			NewNode(ColumnExpression, 1, 1),
 			NewNode(OtherColumnName, 0, 1),
 		}}
 	case OtherColumnName:
 		return [][]*Node{{
 			NewNode(ComparisonOperator, 1, 1),
-			NewNode(ColumnName, 1, 1),
+			NewNode(ColumnExpression, 1, 1),
 		}}
 	case OtherColumnComparison:
 		return [][]*Node{{
@@ -231,19 +233,19 @@ func (n *Node) GetFollowSet() []token.Type {
 		tokenTypeList = append(tokenTypeList, NewNode(OtherColumns, 0, -1).GetFirstSet()...)
 	case ColumnExpression:
 		tokenTypeList = append(tokenTypeList, NewNode(AliasName, 0, 1).GetFirstSet()...)
+		tokenTypeList = append(tokenTypeList, NewNode(OtherColumnName, 0, 1).GetFirstSet()...)
 	case ColumnName:
 		tokenTypeList = append(tokenTypeList, NewNode(OtherExpression, 0, -1).GetFirstSet()...)
 	case ExpressionOperator:
 		tokenTypeList = append(tokenTypeList, NewNode(ColumnName, 1, 1).GetFirstSet()...)
-		tokenTypeList = append(tokenTypeList, NewNode(LiteralExpression, 1, 1).GetFirstSet()...)
 	case Literal:
-		tokenTypeList = append(tokenTypeList, NewNode(OtherLiteral, 0, -1).GetFirstSet()...)
+		tokenTypeList = append(tokenTypeList, NewNode(OtherExpression, 0, -1).GetFirstSet()...)
 	case TableName:
 		tokenTypeList = append(tokenTypeList, NewNode(WhereClause, 0, 1).GetFirstSet()...)
 	case ColumnComparison:
 		tokenTypeList = append(tokenTypeList, NewNode(OtherColumnComparison, 0, -1).GetFirstSet()...)
 	case ComparisonOperator:
-		tokenTypeList = append(tokenTypeList, NewNode(ColumnName, 1, 1).GetFirstSet()...)
+		tokenTypeList = append(tokenTypeList, NewNode(ColumnExpression, 1, 1).GetFirstSet()...)
 	case WhereOperator:
 		tokenTypeList = append(tokenTypeList, NewNode(ColumnComparison, 1, 1).GetFirstSet()...)
 	default:
@@ -254,7 +256,6 @@ func (n *Node) GetFollowSet() []token.Type {
 		// ColumnWithAlias
 		// OtherExpression
 		// LiteralExpression
-		// OtherLiteral
 		// AliasName
 		// WhereClause
 		// OtherColumnName
@@ -267,6 +268,56 @@ func (n *Node) GetFollowSet() []token.Type {
 
 func (n *Node) SetToken(t *token.Token) {
 	n.Token = t
+	n.Span = t.GetSpan()
+}
+
+// GetSpan returns the span of the node, for a non-terminal node, it covers all the children
+func (n *Node) GetSpan() token.Span {
+	return n.Span
+}
+
+// UpdateSpan updates the span of the non-terminal node with the spans of its children,
+// the spans of the children should be updated before calling this function
+func (n *Node) UpdateSpan() {
+	if n.IsTerminal() {
+		return
+	}
+
+	span := token.Span{}
+	for _, child := range n.Children {
+		span = span.Merge(child.GetSpan())
+	}
+
+	n.Span = span
+}
+
+// UpdateSpanRecursively updates the spans of the node and all its descendants from the bottom up
+func (n *Node) UpdateSpanRecursively() {
+	for _, child := range n.Children {
+		child.UpdateSpanRecursively()
+	}
+
+	n.UpdateSpan()
+}
+
+// GetPlaceholders returns the placeholder nodes in the order they appear in the sql,
+// so the caller could validate the count of the arguments of the prepared statement
+func (n *Node) GetPlaceholders() []*Node {
+	var placeholders []*Node
+
+	if n.IsTerminal() {
+		if n.Type.GetTokenType().IsPlaceholder() {
+			placeholders = append(placeholders, n)
+		}
+
+		return placeholders
+	}
+
+	for _, child := range n.Children {
+		placeholders = append(placeholders, child.GetPlaceholders()...)
+	}
+
+	return placeholders
 }
 
 func (n *Node) SetRepeatTime(min, max int) {
@@ -298,6 +349,8 @@ func (n *Node) printChildren(i, j int) {
 	nodeString := fmt.Sprintf("%s%s", prefix, n.Type.String())
 	if n.Token != nil {
 		nodeString += fmt.Sprintf("(%s)", n.Token.String())
+	} else if n.Span.IsValid() {
+		nodeString += fmt.Sprintf("(span: %s)", n.Span.String())
 	}
 	fmt.Println(nodeString)
 	i += 3
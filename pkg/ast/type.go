@@ -24,6 +24,7 @@ const (
 	ExpressionOperator
 	LiteralExpression
 	Literal
+	// OtherLiteral is not used by the grammar any more, it is kept so that the values of the following types do not change
 	OtherLiteral
 	ColumnComparison
 	OtherColumnName
@@ -42,6 +43,10 @@ const (
 	Identifier
 	StringLiteral
 	NumberLiteral
+	PositionalPlaceholder
+	NamedPlaceholder
+	UserVariable
+	SystemVariable
 	SemicolonOperator
 	CommaOperator
 	PlusOperator
@@ -124,6 +129,14 @@ func (t Type) String() string {
 		return "stringLiteral"
 	case NumberLiteral:
 		return "numberLiteral"
+	case PositionalPlaceholder:
+		return "positionalPlaceholder"
+	case NamedPlaceholder:
+		return "namedPlaceholder"
+	case UserVariable:
+		return "userVariable"
+	case SystemVariable:
+		return "systemVariable"
 	case SemicolonOperator:
 		return "semicolonOperator"
 	case CommaOperator:
@@ -178,6 +191,14 @@ func (t Type) GetTokenType() token.Type {
 			return token.StringLiteral
 		case NumberLiteral:
 			return token.NumberLiteral
+		case PositionalPlaceholder:
+			return token.PositionalPlaceholder
+		case NamedPlaceholder:
+			return token.NamedPlaceholder
+		case UserVariable:
+			return token.UserVariable
+		case SystemVariable:
+			return token.SystemVariable
 		case SemicolonOperator:
 			return token.Semicolon
 		case CommaOperator:
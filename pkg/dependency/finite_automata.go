@@ -10,8 +10,26 @@ type Lexer interface {
 	Print()
 	// Match matches the given runes and returns proper token
 	Match(runes []rune) *token.Token
+	// NewWalker returns a new walker which walks through the finite automata rune by rune
+	NewWalker() Walker
+}
+
+type Walker interface {
+	// Reset resets the walker to the initial state/set of the finite automata
+	Reset()
+	// Step transits to the next state/set with the given rune, it returns false if there is no such transition,
+	// and the walker will stay where it was
+	Step(c rune) bool
+	// GetTokenType returns the token type of the runes that have been walked through,
+	// it returns false if the walker is not at a final state/set
+	GetTokenType() (token.Type, bool)
 }
 
 type Parser interface {
 	Match() (*ast.Node, error)
 }
+
+type TokenReader interface {
+	// Next returns the next token, it returns io.EOF when there is no more token
+	Next() (*token.Token, error)
+}
@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+)
+
+const (
+	// labelSeparator separates the labels of the edges which have the same source and target
+	labelSeparator = ", "
+	// startNode is the invisible node which points to the init node
+	startNode = "start"
+)
+
+// Node is a node of the graph, it is a state of the finite automata
+type Node struct {
+	ID      int
+	Label   string
+	IsFinal bool
+}
+
+// NewNode returns a new *Node
+func NewNode(id int, label string, isFinal bool) *Node {
+	return &Node{
+		ID:      id,
+		Label:   label,
+		IsFinal: isFinal,
+	}
+}
+
+// Edge is a directed edge of the graph, it is a transition of the finite automata
+type Edge struct {
+	From  int
+	To    int
+	Label string
+}
+
+// NewEdge returns a new *Edge
+func NewEdge(from, to int, label string) *Edge {
+	return &Edge{
+		From:  from,
+		To:    to,
+		Label: label,
+	}
+}
+
+// Graph is a directed graph which could be written in the DOT language of Graphviz
+type Graph struct {
+	Name  string
+	Init  int
+	Nodes map[int]*Node
+	Edges []*Edge
+	// edges maps the source and the target to the edge, so that the edges of the same source and target are merged
+	edges map[[2]int]*Edge
+}
+
+// NewGraph returns a new *Graph, the init node is marked by an arrow
+func NewGraph(name string, init int) *Graph {
+	return &Graph{
+		Name:  name,
+		Init:  init,
+		Nodes: make(map[int]*Node),
+		edges: make(map[[2]int]*Edge),
+	}
+}
+
+// AddNode adds the node to the graph, the node of the same id will be replaced
+func (g *Graph) AddNode(node *Node) {
+	g.Nodes[node.ID] = node
+}
+
+// AddEdge adds an edge to the graph,
+// if there is already an edge of the same source and target, the label will be appended to the label of the existing edge
+func (g *Graph) AddEdge(from, to int, label string) {
+	key := [2]int{from, to}
+	edge, ok := g.edges[key]
+	if ok {
+		edge.Label += labelSeparator + label
+		return
+	}
+
+	edge = NewEdge(from, to, label)
+	g.edges[key] = edge
+	g.Edges = append(g.Edges, edge)
+}
+
+// Prune removes the nodes that are not on any path from the init node to the target nodes,
+// the target nodes are the nodes that the given function returns true
+func (g *Graph) Prune(isTarget func(node *Node) bool) {
+	next := make(map[int][]int)
+	prev := make(map[int][]int)
+	for _, edge := range g.Edges {
+		next[edge.From] = append(next[edge.From], edge.To)
+		prev[edge.To] = append(prev[edge.To], edge.From)
+	}
+
+	var targets []int
+	for id, node := range g.Nodes {
+		if isTarget(node) {
+			targets = append(targets, id)
+		}
+	}
+
+	reachable := getReachable([]int{g.Init}, next)
+	coReachable := getReachable(targets, prev)
+	for id := range g.Nodes {
+		if !reachable[id] || !coReachable[id] {
+			delete(g.Nodes, id)
+		}
+	}
+
+	var edges []*Edge
+	for _, edge := range g.Edges {
+		_, fromOK := g.Nodes[edge.From]
+		_, toOK := g.Nodes[edge.To]
+		if fromOK && toOK {
+			edges = append(edges, edge)
+			continue
+		}
+		delete(g.edges, [2]int{edge.From, edge.To})
+	}
+	g.Edges = edges
+}
+
+// WriteDOT writes the graph in the DOT language to the writer,
+// the nodes are written in ascending order of the ids, the edges are written in the order of adding
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("digraph %s {\n", quote(g.Name)))
+	builder.WriteString("\trankdir=LR;\n")
+	builder.WriteString("\tnode [shape=circle];\n")
+
+	_, ok := g.Nodes[g.Init]
+	if ok {
+		builder.WriteString(fmt.Sprintf("\t%s [shape=point];\n", startNode))
+		builder.WriteString(fmt.Sprintf("\t%s -> %d;\n", startNode, g.Init))
+	}
+
+	ids := make([]int, constant.ZeroInt, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		node := g.Nodes[id]
+		shape := "circle"
+		if node.IsFinal {
+			shape = "doublecircle"
+		}
+		builder.WriteString(fmt.Sprintf("\t%d [label=%s, shape=%s];\n", node.ID, quote(node.Label), shape))
+	}
+
+	for _, edge := range g.Edges {
+		builder.WriteString(fmt.Sprintf("\t%d -> %d [label=%s];\n", edge.From, edge.To, quote(edge.Label)))
+	}
+	builder.WriteString("}\n")
+
+	_, err := io.WriteString(w, builder.String())
+
+	return errors.Trace(err)
+}
+
+// getReachable returns the nodes that could be reached from the given nodes through the given edges
+func getReachable(from []int, edges map[int][]int) map[int]bool {
+	reachable := make(map[int]bool)
+	stack := append([]int{}, from...)
+	for _, id := range from {
+		reachable[id] = true
+	}
+
+	for len(stack) > constant.ZeroInt {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, next := range edges[id] {
+			if !reachable[next] {
+				reachable[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+
+	return reachable
+}
+
+// quote returns the quoted string of the DOT language
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+
+	return `"` + s + `"`
+}
@@ -1,44 +1,80 @@
 package lexer
 
+import (
+	"unicode"
+)
+
 const (
 	// ascii boundary
-	digitStart    = 48
-	digitEnd      = 57
-	alphabetStart = 97
-	alphabetEnd   = 122
+	digitStart         = 48
+	digitEnd           = 57
+	alphabetStart      = 97
+	alphabetEnd        = 122
+	upperAlphabetStart = 65
+	upperAlphabetEnd   = 90
+	controlStart       = 0
+	controlEnd         = 31
+	printableStart     = 32
+	printableEnd       = 126
+	deleteRune         = 127
+	// the runes which are not ascii
+	nonASCIIStart = 128
+	// the extended runes which could be used in the unquoted identifiers of mysql
+	extendedStart = 0x0080
+	extendedEnd   = 0xFFFF
 
 	underBarRune = '_'
 	singleQuote  = '\''
 	EpsilonRune  = 'ε'
 )
 
+// ExtendedAlphabets contains the non-ascii runes which are permitted in the unquoted identifiers of mysql,
+// they are treated as alphabets
+var ExtendedAlphabets = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: extendedStart, Hi: extendedEnd, Stride: 1},
+	},
+}
+
 type CharacterSet struct {
 	Alphabets []rune
 	Digits    []rune
+	// Tables contains the runes which are treated as alphabets besides the Alphabets, e.g. the non-ascii runes
+	Tables []*unicode.RangeTable
 }
 
 // NewCharacterSet returns a new *CharacterSet
 func NewCharacterSet(alphabets, digits []rune) *CharacterSet {
+	return NewCharacterSetWithTables(alphabets, digits)
+}
+
+// NewCharacterSetWithTables returns a new *CharacterSet, the runes of the tables are treated as alphabets
+func NewCharacterSetWithTables(alphabets, digits []rune, tables ...*unicode.RangeTable) *CharacterSet {
 	return &CharacterSet{
 		Alphabets: alphabets,
 		Digits:    digits,
+		Tables:    tables,
 	}
 }
 
-// NewCharacterSetWithDefault returns a new *CharacterSet with default
+// NewCharacterSetWithDefault returns a new *CharacterSet with default,
+// the alphabets are a-z, A-Z, _ and the extended runes U+0080..U+FFFF
 func NewCharacterSetWithDefault() *CharacterSet {
 	alphabets := []rune{underBarRune}
 
 	for i := alphabetStart; i <= alphabetEnd; i++ {
 		alphabets = append(alphabets, rune(i))
 	}
+	for i := upperAlphabetStart; i <= upperAlphabetEnd; i++ {
+		alphabets = append(alphabets, rune(i))
+	}
 
 	var digits []rune
 	for i := digitStart; i <= digitEnd; i++ {
 		digits = append(digits, rune(i))
 	}
 
-	return NewCharacterSet(alphabets, digits)
+	return NewCharacterSetWithTables(alphabets, digits, ExtendedAlphabets)
 }
 
 // GetAlphabets returns the alphabet runes
@@ -46,7 +82,43 @@ func (cs *CharacterSet) GetAlphabets() []rune {
 	return cs.Alphabets
 }
 
-// GetAlphabets returns the digit runes
+// GetDigits returns the digit runes
 func (cs *CharacterSet) GetDigits() []rune {
 	return cs.Digits
 }
+
+// GetTables returns the range tables of which runes are treated as alphabets
+func (cs *CharacterSet) GetTables() []*unicode.RangeTable {
+	return cs.Tables
+}
+
+// GetAlphabetRanges returns the ranges of the alphabet runes, including the runes of the tables
+func (cs *CharacterSet) GetAlphabetRanges() []RuneRange {
+	ranges := getRangesOfRunes(cs.Alphabets)
+	for _, table := range cs.Tables {
+		ranges = append(ranges, getRangesOfTable(table)...)
+	}
+
+	return normalizeRanges(ranges)
+}
+
+// GetDigitRanges returns the ranges of the digit runes
+func (cs *CharacterSet) GetDigitRanges() []RuneRange {
+	return getRangesOfRunes(cs.Digits)
+}
+
+// GetRanges returns the ranges of all the runes of the character set,
+// the control runes including the white spaces, the printable ascii runes and all the non-ascii runes are always included,
+// it is used as the universe of the negated character class and the '.' of the rule patterns,
+// so that a string literal or a comment could contain any rune
+func (cs *CharacterSet) GetRanges() []RuneRange {
+	ranges := append(cs.GetAlphabetRanges(), cs.GetDigitRanges()...)
+	ranges = append(ranges,
+		NewRuneRange(controlStart, controlEnd),
+		NewRuneRange(printableStart, printableEnd),
+		NewRuneRange(deleteRune, deleteRune),
+		NewRuneRange(nonASCIIStart, unicode.MaxRune),
+	)
+
+	return normalizeRanges(ranges)
+}
@@ -0,0 +1,43 @@
+package lexer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/romberli/go-util/constant"
+)
+
+const (
+	// DefaultServerVersion is the mysql server version that the executable comments are executed on, it means 8.0.40
+	DefaultServerVersion = 80040
+
+	doubleDashCommentPrefix = "--"
+	blockCommentPrefix      = "/*"
+	executableCommentPrefix = "/*!"
+	blockCommentSuffix      = "*/"
+	// the version of the executable comment is a 5-digit number, e.g. 80000 means 8.0.0
+	executableCommentVersionLength = 5
+)
+
+// parseExecutableComment parses the executable comment like /*!80000 ... */,
+// it returns the prefix including the version, the version and the content between the prefix and the suffix,
+// the version is 0 if the executable comment does not specify the version,
+// ok is false if the lexeme is not an executable comment
+func parseExecutableComment(lexeme string) (prefix string, version int, content string, ok bool) {
+	if !strings.HasPrefix(lexeme, executableCommentPrefix) || !strings.HasSuffix(lexeme, blockCommentSuffix) ||
+		len(lexeme) < len(executableCommentPrefix)+len(blockCommentSuffix) {
+		return constant.EmptyString, constant.ZeroInt, constant.EmptyString, false
+	}
+
+	prefixLength := len(executableCommentPrefix)
+	body := lexeme[prefixLength : len(lexeme)-len(blockCommentSuffix)]
+	if len(body) >= executableCommentVersionLength {
+		v, err := strconv.Atoi(body[:executableCommentVersionLength])
+		if err == nil && IsDigit(rune(body[constant.ZeroInt])) {
+			version = v
+			prefixLength += executableCommentVersionLength
+		}
+	}
+
+	return lexeme[:prefixLength], version, lexeme[prefixLength : len(lexeme)-len(blockCommentSuffix)], true
+}
@@ -1,7 +1,10 @@
 package lexer
 
 import (
+	"strconv"
+
 	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
@@ -12,12 +15,19 @@ type DFA struct {
 	NFA          *NFA
 	Index        int
 	InitSet      *Set
+	Sets         []*Set
 }
 
-// NewDFA returns a new *DFA
+// NewDFA returns a new *DFA which recognizes the built-in tokens
 func NewDFA(cs *CharacterSet) *DFA {
+	return NewDFAWithNFA(NewNFA(cs))
+}
+
+// NewDFAWithNFA returns a new *DFA which is converted from the given NFA by subset construction
+func NewDFAWithNFA(nfa *NFA) *DFA {
 	dfa := &DFA{
-		CharacterSet: cs,
+		CharacterSet: nfa.CharacterSet,
+		NFA:          nfa,
 		Index:        -1,
 	}
 
@@ -26,6 +36,16 @@ func NewDFA(cs *CharacterSet) *DFA {
 	return dfa
 }
 
+// NewDFAWithRules returns a new *DFA which is generated from the given rules
+func NewDFAWithRules(cs *CharacterSet, rules []*Rule) (*DFA, error) {
+	nfa, err := NewNFAWithRules(cs, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDFAWithNFA(nfa), nil
+}
+
 // NewDFAWithDefault returns a new *DFA with default
 func NewDFAWithDefault() *DFA {
 	cs := NewCharacterSetWithDefault()
@@ -35,15 +55,14 @@ func NewDFAWithDefault() *DFA {
 
 // init initialize the DFA
 func (dfa *DFA) init() {
-	nfa := NewNFA(dfa.CharacterSet)
-	dfa.NFA = nfa
+	nfa := dfa.NFA
 
 	dfa.InitSet = dfa.getNewSet()
 
 	allSets := []*Set{dfa.InitSet}
 
 	// initialize the first set
-	for _, state := range nfa.InitState.EpsilonMove() {
+	for _, state := range nfa.getClosure(nfa.InitState) {
 		dfa.InitSet.AddState(state)
 	}
 
@@ -52,9 +71,8 @@ func (dfa *DFA) init() {
 	// put the init set to the channel
 	setChan <- dfa.InitSet
 
-	var (
-		setExists bool
-	)
+	// keys is used to find the existing set which contains the same states
+	keys := map[string]*Set{dfa.InitSet.getKey(): dfa.InitSet}
 	for {
 		if len(setChan) == constant.ZeroInt {
 			// all sets are processed
@@ -63,50 +81,174 @@ func (dfa *DFA) init() {
 		}
 		// get a set from the channel
 		currentSet := <-setChan
-		nextRunes := map[rune]bool{}
-		// get all the next runes except the MayBeEpsilon of the states in the set
+		// get all the transitions of the states in the set, the epsilon moves are not included
+		var (
+			transitions []*Transition
+			ranges      []RuneRange
+		)
 		for _, state := range currentSet.States {
-			for c := range state.Next {
-				if c != token.EpsilonRune {
-					nextRunes[c] = true
-				}
+			for _, t := range state.Transitions {
+				transitions = append(transitions, t)
+				ranges = append(ranges, t.Range)
 			}
 		}
 
-		for c := range nextRunes {
+		// the runes of the same split range always transit to the same states, so they share the same next set
+		for _, r := range splitRanges(ranges) {
+			var nextStates []*State
+			for _, t := range transitions {
+				if t.Range.Contains(r.Start) {
+					nextStates = append(nextStates, t.Next)
+				}
+			}
+
 			// create a new set
 			nextSet := dfa.getNewSet()
+			// get the next states of the range and also all the epsilon move states
+			for _, state := range nfa.epsilonClosure(nextStates) {
+				nextSet.AddState(state)
+			}
 
-			for _, state := range currentSet.States {
-				for _, ns := range state.Next[c] {
-					// get the next state of c and also all the epsilon move states
-					for _, epsilonState := range ns.EpsilonMove() {
-						nextSet.AddState(epsilonState)
-					}
-				}
+			key := nextSet.getKey()
+			set, ok := keys[key]
+			if ok {
+				// this set already exists, use the old one as the next set of the current set
+				currentSet.AddRangeNext(r, set)
+				dfa.Index--
+				continue
 			}
 
-			for _, set := range allSets {
-				if set.Equal(nextSet) {
-					// this set already exists, use the old one as the next set of the current set
-					currentSet.Next[c] = set
-					setExists = true
-					dfa.Index--
-					break
-				}
+			// this is a brand-new set, add this to the all set
+			allSets = append(allSets, nextSet)
+			keys[key] = nextSet
+			// use the new one as the next set of the current set
+			currentSet.AddRangeNext(r, nextSet)
+			// send the new set to the channel and wait to be processed
+			setChan <- nextSet
+		}
+	}
+
+	dfa.Sets = allSets
+}
+
+// GetSetCount returns the number of the sets of the DFA
+func (dfa *DFA) GetSetCount() int {
+	return len(dfa.Sets)
+}
+
+// Minimize merges the equivalent sets of the DFA with Moore's partition refinement,
+// two sets are equivalent if they have the same token type and transit to the equivalent sets with every rune,
+// so the minimized DFA matches exactly the same runes as before
+func (dfa *DFA) Minimize() {
+	// the sets are numbered by their positions in dfa.Sets,
+	// the ranges and the next sets never change while refining, so they are prepared only once
+	positions := make(map[int]int, len(dfa.Sets))
+	for i, set := range dfa.Sets {
+		positions[set.Index] = i
+	}
+	ranges := make([][]RuneRange, len(dfa.Sets))
+	nexts := make([][]int, len(dfa.Sets))
+	for i, set := range dfa.Sets {
+		ranges[i] = make([]RuneRange, len(set.Next))
+		nexts[i] = make([]int, len(set.Next))
+		for j, t := range set.Next {
+			ranges[i][j] = t.Range
+			nexts[i][j] = positions[t.Next.Index]
+		}
+	}
+
+	// at first, the sets are partitioned by the token types, the non-final sets use token.Error as the token type
+	blocks := make([]int, len(dfa.Sets))
+	finalBlocks := make(map[token.Type]int)
+	for i, set := range dfa.Sets {
+		tokenType := token.Error
+		if set.IsFinal {
+			tokenType = set.TokenType
+		}
+		block, ok := finalBlocks[tokenType]
+		if !ok {
+			block = len(finalBlocks)
+			finalBlocks[tokenType] = block
+		}
+		blocks[i] = block
+	}
+	blockCount := len(finalBlocks)
+
+	for {
+		// split the blocks by the blocks that the sets transit to
+		newBlocks := make([]int, len(dfa.Sets))
+		signatures := make(map[string]int)
+		for i := range dfa.Sets {
+			signature := getSignature(blocks[i], ranges[i], nexts[i], blocks)
+			block, ok := signatures[signature]
+			if !ok {
+				block = len(signatures)
+				signatures[signature] = block
 			}
-			if !setExists {
-				// this is a brand-new set, add this to the all set
-				allSets = append(allSets, nextSet)
-				// use the new one as the next set of the current set
-				currentSet.Next[c] = nextSet
-				// send the new set to the channel and wait to be processed
-				setChan <- nextSet
+			newBlocks[i] = block
+		}
+
+		blocks = newBlocks
+		if len(signatures) == blockCount {
+			// no block is split, the partition is stable
+			break
+		}
+		blockCount = len(signatures)
+	}
+
+	setBlocks := make(map[int]int, len(dfa.Sets))
+	for i, set := range dfa.Sets {
+		setBlocks[set.Index] = blocks[i]
+	}
+
+	dfa.merge(setBlocks)
+}
+
+// merge merges the sets of the same block into one set, the new sets are indexed in the breadth-first order
+func (dfa *DFA) merge(blocks map[int]int) {
+	members := make(map[int][]*Set)
+	for _, set := range dfa.Sets {
+		members[blocks[set.Index]] = append(members[blocks[set.Index]], set)
+	}
+
+	var (
+		allSets   []*Set
+		setBlocks []int
+	)
+	newSets := make(map[int]*Set)
+	getSet := func(block int) *Set {
+		ns, ok := newSets[block]
+		if !ok {
+			ns = dfa.getNewSet()
+			for _, set := range members[block] {
+				for _, state := range set.States {
+					if !ns.Contains(state) {
+						ns.States = append(ns.States, state)
+					}
+				}
 			}
+			ns.IsFinal = members[block][constant.ZeroInt].IsFinal
+			ns.TokenType = members[block][constant.ZeroInt].TokenType
+			newSets[block] = ns
+			allSets = append(allSets, ns)
+			setBlocks = append(setBlocks, block)
+		}
 
-			setExists = false
+		return ns
+	}
+
+	dfa.Index = -1
+	dfa.InitSet = getSet(blocks[dfa.InitSet.Index])
+	// all sets in the same block transit to the same blocks, so any of them could be used to add the next sets,
+	// allSets grows while adding the next sets, it works as the queue of the breadth-first traversal
+	for i := 0; i < len(allSets); i++ {
+		set := members[setBlocks[i]][constant.ZeroInt]
+		for _, t := range set.Next {
+			allSets[i].AddRangeNext(t.Range, getSet(blocks[t.Next.Index]))
 		}
 	}
+
+	dfa.Sets = allSets
 }
 
 // Print prints all the sets including the state in it
@@ -120,7 +262,7 @@ func (dfa *DFA) Match(runes []rune) *token.Token {
 
 	for _, c := range runes {
 		// transit to the next set
-		tempSet = tempSet.Next[c]
+		tempSet = tempSet.GetNext(c)
 		if tempSet == nil {
 			return token.NewToken(token.Error, string(runes))
 		}
@@ -134,6 +276,35 @@ func (dfa *DFA) Match(runes []rune) *token.Token {
 	return token.NewToken(token.Error, string(runes))
 }
 
+// NewWalker returns a new walker of the DFA
+func (dfa *DFA) NewWalker() dependency.Walker {
+	return NewDFAWalker(dfa)
+}
+
+// getSignature returns the signature of the set under the given partition,
+// two sets have the same signature if they are in the same block and transit to the same blocks with every rune,
+// the adjacent ranges which transit to the same block are merged, so that the signature does not depend on how the ranges are split
+func getSignature(block int, ranges []RuneRange, nexts []int, blocks []int) string {
+	buf := make([]byte, constant.ZeroInt, 64)
+
+	buf = strconv.AppendInt(buf, int64(block), 10)
+	for i := 0; i < len(ranges); {
+		j := i + 1
+		for j < len(ranges) && ranges[j].Start == ranges[j-1].End+1 && blocks[nexts[j]] == blocks[nexts[i]] {
+			j++
+		}
+		buf = append(buf, '|')
+		buf = strconv.AppendInt(buf, int64(ranges[i].Start), 10)
+		buf = append(buf, '-')
+		buf = strconv.AppendInt(buf, int64(ranges[j-1].End), 10)
+		buf = append(buf, ':')
+		buf = strconv.AppendInt(buf, int64(blocks[nexts[i]]), 10)
+		i = j
+	}
+
+	return string(buf)
+}
+
 // getNewSet gets a new set
 func (dfa *DFA) getNewSet() *Set {
 	dfa.Index++
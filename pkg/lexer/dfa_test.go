@@ -1,8 +1,11 @@
 package lexer
 
 import (
-	"fmt"
 	"testing"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -20,6 +23,8 @@ func initTestDFA() {
 func TestDFA_All(t *testing.T) {
 	TestDFA_Print(t)
 	TestDFA_Match(t)
+	TestDFA_Minimize(t)
+	TestDFA_MinimizeEquivalentSets(t)
 }
 
 func TestDFA_Print(t *testing.T) {
@@ -27,10 +32,81 @@ func TestDFA_Print(t *testing.T) {
 }
 
 func TestDFA_Match(t *testing.T) {
+	asst := assert.New(t)
+
 	strList := []string{"select", "and", "as", "selectt", "'string'", "123", "123abc", ">=", "123."}
 
+	// the dfa must be equivalent to the nfa which it is built from
 	for _, str := range strList {
-		token := testDFA.Match([]rune(str))
-		fmt.Println(token.String())
+		asst.Equal(testNFA.Match([]rune(str)), testDFA.Match([]rune(str)), "test Match() failed. str: %s", str)
+	}
+}
+
+// getTestInputs returns all the strings of which length is not larger than maxLength and consist of the given runes
+func getTestInputs(runes []rune, maxLength int) []string {
+	inputs := []string{""}
+	last := []string{""}
+	for i := 0; i < maxLength; i++ {
+		var current []string
+		for _, str := range last {
+			for _, c := range runes {
+				current = append(current, str+string(c))
+			}
+		}
+		inputs = append(inputs, current...)
+		last = current
+	}
+
+	return inputs
+}
+
+func TestDFA_Minimize(t *testing.T) {
+	asst := assert.New(t)
+
+	dfa := NewDFAWithDefault()
+	before := dfa.GetSetCount()
+	dfa.Minimize()
+	after := dfa.GetSetCount()
+	t.Logf("set count before minimizing: %d, after minimizing: %d", before, after)
+	asst.LessOrEqual(after, before, "test Minimize() failed")
+
+	inputs := getTestInputs([]rune("selctanwhrom1_' <>=!,;"), 4)
+	inputs = append(inputs, "select", "from", "where", "selectt", "'abc123_'", "123abc", "123.", "and1", "or_", "中文", "'中文'", "é1")
+	for _, input := range inputs {
+		asst.Equal(testDFA.Match([]rune(input)), dfa.Match([]rune(input)), "test Minimize() failed. input: %s", input)
+	}
+}
+
+func TestDFA_MinimizeEquivalentSets(t *testing.T) {
+	asst := assert.New(t)
+
+	// set 0 transits to set 1 with 'a' and to set 2 with 'b', both set 1 and set 2 are identifier sets and
+	// transit to set 3 with 'c', set 3 is an identifier set without any transition,
+	// so set 1 and set 2 are equivalent, set 3 is not as it has no transition
+	dfa := &DFA{Index: -1}
+	for i := 0; i < 4; i++ {
+		dfa.Sets = append(dfa.Sets, dfa.getNewSet())
+	}
+	dfa.InitSet = dfa.Sets[0]
+	for _, set := range dfa.Sets[1:] {
+		set.IsFinal = true
+		set.TokenType = token.Identifier
+	}
+	dfa.Sets[0].AddNext('a', dfa.Sets[1])
+	dfa.Sets[0].AddNext('b', dfa.Sets[2])
+	dfa.Sets[1].AddNext('c', dfa.Sets[3])
+	dfa.Sets[2].AddNext('c', dfa.Sets[3])
+
+	inputs := getTestInputs([]rune("abc"), 4)
+	expected := make([]*token.Token, len(inputs))
+	for i, input := range inputs {
+		expected[i] = dfa.Match([]rune(input))
+	}
+
+	dfa.Minimize()
+	asst.Equal(3, dfa.GetSetCount(), "test Minimize() failed")
+	asst.Equal(constant.ZeroInt, dfa.InitSet.Index, "test Minimize() failed")
+	for i, input := range inputs {
+		asst.Equal(expected[i], dfa.Match([]rune(input)), "test Minimize() failed. input: %s", input)
 	}
 }
@@ -0,0 +1,115 @@
+package lexer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+type DiagnosticCode int
+
+const (
+	// UnknownCharacter means the runes could not be the beginning of any token
+	UnknownCharacter DiagnosticCode = iota + 1
+	// UnterminatedString means the closing quote of a string is missing
+	UnterminatedString
+	// UnterminatedQuotedIdentifier means the closing backtick of a quoted identifier is missing
+	UnterminatedQuotedIdentifier
+	// UnterminatedComment means the closing */ of a block comment is missing
+	UnterminatedComment
+	// InvalidToken means the runes look like the beginning of a token but could not be a complete token
+	InvalidToken
+	// InvalidHexLiteral means the quoted hexadecimal literal like x'123' has an odd number of digits or a non-hexadecimal digit
+	InvalidHexLiteral
+	// InvalidBitLiteral means the quoted bit-value literal like b'102' has a non-binary digit
+	InvalidBitLiteral
+)
+
+// String returns the string representation of the diagnostic code
+func (dc DiagnosticCode) String() string {
+	switch dc {
+	case UnknownCharacter:
+		return "unknownCharacter"
+	case UnterminatedString:
+		return "unterminatedString"
+	case UnterminatedQuotedIdentifier:
+		return "unterminatedQuotedIdentifier"
+	case UnterminatedComment:
+		return "unterminatedComment"
+	case InvalidToken:
+		return "invalidToken"
+	case InvalidHexLiteral:
+		return "invalidHexLiteral"
+	case InvalidBitLiteral:
+		return "invalidBitLiteral"
+	default:
+		return "unknown"
+	}
+}
+
+// Diagnostic describes a problem of the input text found by the lexer
+type Diagnostic struct {
+	Code    DiagnosticCode
+	Message string
+	Span    token.Span
+	Text    string
+}
+
+// NewDiagnostic returns a new *Diagnostic
+func NewDiagnostic(code DiagnosticCode, message string, span token.Span, text string) *Diagnostic {
+	return &Diagnostic{
+		Code:    code,
+		Message: message,
+		Span:    span,
+		Text:    text,
+	}
+}
+
+// NewDiagnosticWithToken returns a new *Diagnostic which describes the given error token
+func NewDiagnosticWithToken(code DiagnosticCode, t *token.Token) *Diagnostic {
+	return newDiagnosticWithQuote(code, t, constant.ZeroInt)
+}
+
+// newDiagnosticWithQuote returns a new *Diagnostic which describes the given error token,
+// the quote is the missing closing quote of the unterminated string or quoted identifier, it is 0 if it is unknown
+func newDiagnosticWithQuote(code DiagnosticCode, t *token.Token, quote rune) *Diagnostic {
+	var message string
+	switch code {
+	case UnknownCharacter:
+		message = fmt.Sprintf("unknown character %q", t.Lexeme)
+	case UnterminatedString, UnterminatedQuotedIdentifier:
+		message = "the closing quote is missing"
+		if quote != constant.ZeroInt {
+			message = fmt.Sprintf("the closing quote %c is missing", quote)
+		}
+	case UnterminatedComment:
+		message = fmt.Sprintf("the closing %s of the comment is missing", blockCommentSuffix)
+	case InvalidHexLiteral:
+		message = fmt.Sprintf("the hexadecimal literal %s must have an even number of hexadecimal digits", t.Lexeme)
+	case InvalidBitLiteral:
+		message = fmt.Sprintf("the bit-value literal %s could only have the binary digits", t.Lexeme)
+	default:
+		message = fmt.Sprintf("invalid token %q", t.Lexeme)
+	}
+
+	return NewDiagnostic(code, message, t.Span, t.Lexeme)
+}
+
+// String returns the string representation of the diagnostic
+func (d *Diagnostic) String() string {
+	return fmt.Sprintf(`{code: %s, message: %s, span: %s, text: %s}`, d.Code.String(), d.Message, d.Span.String(), d.Text)
+}
+
+// getInvalidCode returns the diagnostic code of the lexeme which matches a rule of the error token
+func getInvalidCode(lexeme string) DiagnosticCode {
+	switch {
+	case strings.HasPrefix(strings.ToLower(lexeme), "x'"):
+		return InvalidHexLiteral
+	case strings.HasPrefix(strings.ToLower(lexeme), "b'"):
+		return InvalidBitLiteral
+	default:
+		return InvalidToken
+	}
+}
@@ -0,0 +1,74 @@
+package lexer
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// fuzzMaxRuneCount limits the length of the fuzzing input, so that each input could be checked quickly
+	fuzzMaxRuneCount = 256
+)
+
+// FuzzLexer_Match checks the nfa and the dfa match the same token with any rune sequence,
+// the seed corpus is in testdata/fuzz/FuzzLexer_Match
+func FuzzLexer_Match(f *testing.F) {
+	for _, seed := range []string{"select", "selectt", "'abc''d\\'e'", "`a``b`", "0x1f", "1.5e-3", "/* a */", "@@global.a", "<=>", "->>", "中文"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		if utf8.RuneCountInString(input) > fuzzMaxRuneCount {
+			t.Skip()
+		}
+
+		asst := assert.New(t)
+
+		runes := []rune(input)
+		asst.Equal(testNFA.Match(runes), testDFA.Match(runes), "test Match() failed. input: %q", input)
+	})
+}
+
+// FuzzLexer_Lex checks the lexers with the nfa and the dfa return the same tokens and diagnostics with any sql,
+// the raw tokens are the same as the tokens, and the lossless lexer reproduces the sql, the seed corpus is in testdata/fuzz/FuzzLexer_Lex
+func FuzzLexer_Lex(f *testing.F) {
+	for _, seed := range []string{
+		"select a, b from t01 where c = 'abc' and d <> 1.5;",
+		"select /*!80000 a, */ b -- comment\nfrom t01 # comment",
+		"select @a := 1, @@session.b, ?, :c, $1 from t01 where d->>'$.e' <=> 1 || f",
+		"select 'unterminated",
+		"select /*!80000 \xff a */ b",
+	} {
+		f.Add(seed)
+	}
+
+	nfaLexer := NewLexer(testNFA)
+	nfaLexer.SetSkipComments(false)
+	dfaLexer := NewLexer(testDFA)
+	dfaLexer.SetSkipComments(false)
+	losslessLexer := NewLexer(testDFA)
+	losslessLexer.SetLossless(true)
+
+	f.Fuzz(func(t *testing.T, sql string) {
+		if utf8.RuneCountInString(sql) > fuzzMaxRuneCount {
+			t.Skip()
+		}
+
+		asst := assert.New(t)
+
+		nfaTokens, nfaDiagnostics := nfaLexer.LexWithDiagnostics(sql)
+		dfaTokens, dfaDiagnostics := dfaLexer.LexWithDiagnostics(sql)
+		asst.Equal(getTokenTypes(nfaTokens), getTokenTypes(dfaTokens), "test Lex() failed. sql: %q", sql)
+		asst.Equal(getLexemes(nfaTokens), getLexemes(dfaTokens), "test Lex() failed. sql: %q", sql)
+		asst.Equal(nfaDiagnostics, dfaDiagnostics, "test Lex() failed. sql: %q", sql)
+
+		asst.Equal(dfaTokens, NewTokens(sql, dfaLexer.LexRaw(sql, nil)), "test LexRaw() failed. sql: %q", sql)
+		if utf8.ValidString(sql) {
+			// the invalid utf-8 bytes are replaced by utf8.RuneError, so only the valid sql could be reproduced
+			asst.Equal(sql, token.GetFullText(losslessLexer.Lex(sql)), "test Lex() failed. sql: %q", sql)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package lexer
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+)
+
+const (
+	// generatedValuesPerLine is the number of the values in a line of the generated arrays which are not grouped by states
+	generatedValuesPerLine = 16
+)
+
+// generatedTemplate is the template of the go source code of the TableDFA
+var generatedTemplate = template.Must(template.New("tableDFA").Parse(`// Code generated by sql-parser-go generate lexer. DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+// DFA is the precomputed table dfa of the lexer, it has {{.StateCount}} states and {{.ClassCount}} input classes
+var DFA = &lexer.TableDFA{
+	ASCIIClasses: [{{.ASCIISize}}]int{
+{{- range .ASCIIClasses}}
+		{{.}}
+{{- end}}
+	},
+	ClassRanges: []*lexer.ClassRange{
+{{- range .ClassRanges}}
+		{{.}}
+{{- end}}
+	},
+	ClassCount: {{.ClassCount}},
+	StateCount: {{.StateCount}},
+	Transitions: []int{
+{{- range .Transitions}}
+		// {{.Comment}}
+		{{.Values}}
+{{- end}}
+	},
+	Finals: []token.Type{
+{{- range .Finals}}
+		{{.}}
+{{- end}}
+	},
+}
+
+// NewLexer returns a new *lexer.Lexer which uses the precomputed table dfa
+func NewLexer() *lexer.Lexer {
+	return lexer.NewLexer(DFA)
+}
+
+// Match matches the given runes with the precomputed table dfa and returns proper token
+func Match(runes []rune) *token.Token {
+	return DFA.Match(runes)
+}
+`))
+
+// generatedRow is a row of the generated transition table
+type generatedRow struct {
+	Comment string
+	Values  string
+}
+
+// generatedData is the data of the generated template
+type generatedData struct {
+	PackageName  string
+	ASCIISize    int
+	ASCIIClasses []string
+	ClassRanges  []string
+	ClassCount   int
+	StateCount   int
+	Transitions  []*generatedRow
+	Finals       []string
+}
+
+// Generate writes the go source code of the TableDFA to the writer,
+// the generated package contains the static transition table and a Match function,
+// so that the lexer could be used without constructing the finite automata at runtime
+func (td *TableDFA) Generate(w io.Writer, packageName string) error {
+	data := &generatedData{
+		PackageName:  packageName,
+		ASCIISize:    asciiSize,
+		ASCIIClasses: getGeneratedLines(td.ASCIIClasses[:]),
+		ClassCount:   td.ClassCount,
+		StateCount:   td.StateCount,
+	}
+	for _, cr := range td.ClassRanges {
+		data.ClassRanges = append(data.ClassRanges,
+			fmt.Sprintf("{Range: lexer.RuneRange{Start: %d, End: %d}, Class: %d},", cr.Range.Start, cr.Range.End, cr.Class))
+	}
+	for state := 0; state < td.StateCount; state++ {
+		comment := fmt.Sprintf("state %d", state)
+		tokenType, ok := td.GetTokenType(state)
+		if ok {
+			comment = fmt.Sprintf("state %d, final: %s", state, tokenType.String())
+		}
+		row := td.Transitions[state*td.ClassCount : (state+1)*td.ClassCount]
+		data.Transitions = append(data.Transitions, &generatedRow{Comment: comment, Values: getGeneratedValues(row)})
+	}
+	finals := make([]int, len(td.Finals))
+	for i, tokenType := range td.Finals {
+		finals[i] = int(tokenType)
+	}
+	data.Finals = getGeneratedLines(finals)
+
+	var buffer bytes.Buffer
+	err := generatedTemplate.Execute(&buffer, data)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = w.Write(source)
+
+	return errors.Trace(err)
+}
+
+// getGeneratedLines splits the values into the lines of the generated array
+func getGeneratedLines(values []int) []string {
+	var lines []string
+	for i := 0; i < len(values); i += generatedValuesPerLine {
+		end := i + generatedValuesPerLine
+		if end > len(values) {
+			end = len(values)
+		}
+		lines = append(lines, getGeneratedValues(values[i:end]))
+	}
+
+	return lines
+}
+
+// getGeneratedValues returns the go source code of the values, each value is followed by a comma
+func getGeneratedValues(values []int) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = strconv.Itoa(value) + constant.CommaString
+	}
+
+	return strings.Join(strs, constant.SpaceString)
+}
@@ -0,0 +1,106 @@
+package lexer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/romberli/sql-parser-go/pkg/graph"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	nfaGraphName = "nfa"
+	dfaGraphName = "dfa"
+)
+
+// WriteDOT writes the states of the NFA to the writer in the DOT language of Graphviz,
+// the final states are labeled with the token types, if the token types are specified,
+// only the states on the paths from the init state to the final states of the given token types are written
+func (nfa *NFA) WriteDOT(w io.Writer, tokenTypes ...token.Type) error {
+	g := graph.NewGraph(nfaGraphName, nfa.InitState.Index)
+	tokenTypeMap := make(map[int]token.Type)
+
+	visited := map[int]bool{nfa.InitState.Index: true}
+	stack := []*State{nfa.InitState}
+	addNext := func(ns *State) {
+		if !visited[ns.Index] {
+			visited[ns.Index] = true
+			stack = append(stack, ns)
+		}
+	}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		g.AddNode(graph.NewNode(s.Index, getNodeLabel(s.Index, s.IsFinal, s.TokenType), s.IsFinal))
+		if s.IsFinal {
+			tokenTypeMap[s.Index] = s.TokenType
+		}
+		for _, ns := range s.Epsilons {
+			g.AddEdge(s.Index, ns.Index, token.Epsilon.String())
+			addNext(ns)
+		}
+		for _, t := range s.Transitions {
+			g.AddEdge(s.Index, t.Next.Index, getEdgeLabel(t.Range))
+			addNext(t.Next)
+		}
+	}
+
+	pruneGraph(g, tokenTypeMap, tokenTypes)
+
+	return g.WriteDOT(w)
+}
+
+// WriteDOT writes the sets of the DFA to the writer in the DOT language of Graphviz,
+// the final sets are labeled with the token types, if the token types are specified,
+// only the sets on the paths from the init set to the final sets of the given token types are written
+func (dfa *DFA) WriteDOT(w io.Writer, tokenTypes ...token.Type) error {
+	g := graph.NewGraph(dfaGraphName, dfa.InitSet.Index)
+	tokenTypeMap := make(map[int]token.Type)
+
+	for _, s := range dfa.Sets {
+		g.AddNode(graph.NewNode(s.Index, getNodeLabel(s.Index, s.IsFinal, s.TokenType), s.IsFinal))
+		if s.IsFinal {
+			tokenTypeMap[s.Index] = s.TokenType
+		}
+		for _, t := range s.Next {
+			g.AddEdge(s.Index, t.Next.Index, getEdgeLabel(t.Range))
+		}
+	}
+
+	pruneGraph(g, tokenTypeMap, tokenTypes)
+
+	return g.WriteDOT(w)
+}
+
+// pruneGraph removes the nodes which could not reach the final nodes of the given token types,
+// it does nothing if no token type is given
+func pruneGraph(g *graph.Graph, tokenTypeMap map[int]token.Type, tokenTypes []token.Type) {
+	if len(tokenTypes) == 0 {
+		return
+	}
+
+	g.Prune(func(node *graph.Node) bool {
+		tokenType, ok := tokenTypeMap[node.ID]
+		return ok && token.TypeExists(tokenTypes, tokenType)
+	})
+}
+
+// getNodeLabel returns the label of the state or the set, the final one is labeled with the token type
+func getNodeLabel(index int, isFinal bool, tokenType token.Type) string {
+	if isFinal {
+		return fmt.Sprintf("%d: %s", index, tokenType.String())
+	}
+
+	return strconv.Itoa(index)
+}
+
+// getEdgeLabel returns the label of the transition, the runes are quoted so that the invisible runes could be read
+func getEdgeLabel(r RuneRange) string {
+	if r.Start == r.End {
+		return strconv.QuoteRune(r.Start)
+	}
+
+	return strconv.QuoteRune(r.Start) + "-" + strconv.QuoteRune(r.End)
+}
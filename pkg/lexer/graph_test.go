@@ -0,0 +1,76 @@
+package lexer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_All(t *testing.T) {
+	TestGraph_NFA(t)
+	TestGraph_DFA(t)
+	TestGraph_Filter(t)
+}
+
+func TestGraph_NFA(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	nfa, err := NewNFAWithRules(cs, []*Rule{NewRule(token.GE, ">=", DefaultPriority), NewRule(token.WhiteSpace, `[\n]+`, DefaultPriority)})
+	asst.Nil(err, "test WriteDOT() failed")
+
+	var buffer bytes.Buffer
+	err = nfa.WriteDOT(&buffer)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot := buffer.String()
+	asst.Contains(dot, `digraph "nfa" {`, "test WriteDOT() failed")
+	asst.Contains(dot, "start -> 0;", "test WriteDOT() failed")
+	asst.Contains(dot, `[label="ε"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `[label="'>'"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `[label="'\\n'"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `: greaterOrEqual", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.Contains(dot, `: whiteSpace", shape=doublecircle];`, "test WriteDOT() failed")
+}
+
+func TestGraph_DFA(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	dfa, err := NewDFAWithRules(cs, []*Rule{NewRule(token.Identifier, "[a-z]+", DefaultPriority), NewRule(token.StringLiteral, `"[^"]*"`, DefaultPriority)})
+	asst.Nil(err, "test WriteDOT() failed")
+
+	var buffer bytes.Buffer
+	err = dfa.WriteDOT(&buffer)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot := buffer.String()
+	asst.Contains(dot, `digraph "dfa" {`, "test WriteDOT() failed")
+	asst.Contains(dot, `[label="'a'-'z'"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `[label="'\"'"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `: identifier", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.Contains(dot, `: stringLiteral", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.NotContains(dot, "ε", "test WriteDOT() failed")
+}
+
+func TestGraph_Filter(t *testing.T) {
+	asst := assert.New(t)
+
+	var buffer bytes.Buffer
+	err := testDFA.WriteDOT(&buffer, token.As, token.Comma)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot := buffer.String()
+	asst.Contains(dot, `: asKeyword", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.Contains(dot, `: comma", shape=doublecircle];`, "test WriteDOT() failed")
+	// the identifier set is on the path of the as keyword, the edges are the start edge, "a", "s" and ","
+	asst.Contains(dot, `: identifier", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.NotContains(dot, "selectKeyword", "test WriteDOT() failed")
+	asst.Equal(4, bytes.Count(buffer.Bytes(), []byte(" -> ")), "test WriteDOT() failed")
+
+	buffer.Reset()
+	err = testNFA.WriteDOT(&buffer, token.Select)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot = buffer.String()
+	asst.Contains(dot, `: selectKeyword", shape=doublecircle];`, "test WriteDOT() failed")
+	asst.NotContains(dot, "identifier", "test WriteDOT() failed")
+}
@@ -0,0 +1,756 @@
+// Code generated by tools/keyword from tools/keyword/keywords.txt; DO NOT EDIT.
+
+package lexer
+
+import "github.com/romberli/sql-parser-go/pkg/token"
+
+// KeywordMap is the map of the keyword token type and the lower case keyword, keywords are matched case-insensitively
+var KeywordMap = map[token.Type]string{
+	token.AccessibleKeyword: "accessible",
+	token.AccountKeyword:    "account",
+	token.ActionKeyword:     "action",
+	token.ActiveKeyword:     "active",
+	token.AddKeyword:        "add",
+	token.AdminKeyword:      "admin",
+	token.AfterKeyword:      "after",
+	token.AgainstKeyword:    "against",
+	token.AggregateKeyword:  "aggregate",
+	token.AlgorithmKeyword:  "algorithm",
+	token.AllKeyword:        "all",
+	token.AlterKeyword:      "alter",
+	token.AlwaysKeyword:     "always",
+	token.AnalyzeKeyword:    "analyze",
+	token.And:               "and",
+	token.AnyKeyword:        "any",
+	token.ArrayKeyword:      "array",
+	token.As:                "as",
+	token.AscKeyword:        "asc",
+	token.AsciiKeyword:      "ascii",
+	token.AsensitiveKeyword: "asensitive",
+	token.AssignGtidsToAnonymousTransactionsKeyword: "assign_gtids_to_anonymous_transactions",
+	token.AtKeyword:                           "at",
+	token.AttributeKeyword:                    "attribute",
+	token.AuthenticationKeyword:               "authentication",
+	token.AutoIncrementKeyword:                "auto_increment",
+	token.AutoextendSizeKeyword:               "autoextend_size",
+	token.AvgKeyword:                          "avg",
+	token.AvgRowLengthKeyword:                 "avg_row_length",
+	token.BackupKeyword:                       "backup",
+	token.BeforeKeyword:                       "before",
+	token.BeginKeyword:                        "begin",
+	token.BetweenKeyword:                      "between",
+	token.BigintKeyword:                       "bigint",
+	token.BinaryKeyword:                       "binary",
+	token.BinlogKeyword:                       "binlog",
+	token.BitKeyword:                          "bit",
+	token.BlobKeyword:                         "blob",
+	token.BlockKeyword:                        "block",
+	token.BoolKeyword:                         "bool",
+	token.BooleanKeyword:                      "boolean",
+	token.BothKeyword:                         "both",
+	token.BtreeKeyword:                        "btree",
+	token.BucketsKeyword:                      "buckets",
+	token.ByKeyword:                           "by",
+	token.ByteKeyword:                         "byte",
+	token.CacheKeyword:                        "cache",
+	token.CallKeyword:                         "call",
+	token.CascadeKeyword:                      "cascade",
+	token.CascadedKeyword:                     "cascaded",
+	token.CaseKeyword:                         "case",
+	token.CatalogNameKeyword:                  "catalog_name",
+	token.ChainKeyword:                        "chain",
+	token.ChallengeResponseKeyword:            "challenge_response",
+	token.ChangeKeyword:                       "change",
+	token.ChangedKeyword:                      "changed",
+	token.ChannelKeyword:                      "channel",
+	token.CharKeyword:                         "char",
+	token.CharacterKeyword:                    "character",
+	token.CharsetKeyword:                      "charset",
+	token.CheckKeyword:                        "check",
+	token.ChecksumKeyword:                     "checksum",
+	token.CipherKeyword:                       "cipher",
+	token.ClassOriginKeyword:                  "class_origin",
+	token.ClientKeyword:                       "client",
+	token.CloneKeyword:                        "clone",
+	token.CloseKeyword:                        "close",
+	token.CoalesceKeyword:                     "coalesce",
+	token.CodeKeyword:                         "code",
+	token.CollateKeyword:                      "collate",
+	token.CollationKeyword:                    "collation",
+	token.ColumnKeyword:                       "column",
+	token.ColumnFormatKeyword:                 "column_format",
+	token.ColumnNameKeyword:                   "column_name",
+	token.ColumnsKeyword:                      "columns",
+	token.CommentKeyword:                      "comment",
+	token.CommitKeyword:                       "commit",
+	token.CommittedKeyword:                    "committed",
+	token.CompactKeyword:                      "compact",
+	token.CompletionKeyword:                   "completion",
+	token.ComponentKeyword:                    "component",
+	token.CompressedKeyword:                   "compressed",
+	token.CompressionKeyword:                  "compression",
+	token.ConcurrentKeyword:                   "concurrent",
+	token.ConditionKeyword:                    "condition",
+	token.ConnectionKeyword:                   "connection",
+	token.ConsistentKeyword:                   "consistent",
+	token.ConstraintKeyword:                   "constraint",
+	token.ConstraintCatalogKeyword:            "constraint_catalog",
+	token.ConstraintNameKeyword:               "constraint_name",
+	token.ConstraintSchemaKeyword:             "constraint_schema",
+	token.ContainsKeyword:                     "contains",
+	token.ContextKeyword:                      "context",
+	token.ContinueKeyword:                     "continue",
+	token.ConvertKeyword:                      "convert",
+	token.CpuKeyword:                          "cpu",
+	token.CreateKeyword:                       "create",
+	token.CrossKeyword:                        "cross",
+	token.CubeKeyword:                         "cube",
+	token.CumeDistKeyword:                     "cume_dist",
+	token.CurrentKeyword:                      "current",
+	token.CurrentDateKeyword:                  "current_date",
+	token.CurrentTimeKeyword:                  "current_time",
+	token.CurrentTimestampKeyword:             "current_timestamp",
+	token.CurrentUserKeyword:                  "current_user",
+	token.CursorKeyword:                       "cursor",
+	token.CursorNameKeyword:                   "cursor_name",
+	token.DataKeyword:                         "data",
+	token.DatabaseKeyword:                     "database",
+	token.DatabasesKeyword:                    "databases",
+	token.DatafileKeyword:                     "datafile",
+	token.DateKeyword:                         "date",
+	token.DatetimeKeyword:                     "datetime",
+	token.DayKeyword:                          "day",
+	token.DayHourKeyword:                      "day_hour",
+	token.DayMicrosecondKeyword:               "day_microsecond",
+	token.DayMinuteKeyword:                    "day_minute",
+	token.DaySecondKeyword:                    "day_second",
+	token.DeallocateKeyword:                   "deallocate",
+	token.DecKeyword:                          "dec",
+	token.DecimalKeyword:                      "decimal",
+	token.DeclareKeyword:                      "declare",
+	token.DefaultKeyword:                      "default",
+	token.DefaultAuthKeyword:                  "default_auth",
+	token.DefinerKeyword:                      "definer",
+	token.DefinitionKeyword:                   "definition",
+	token.DelayKeyWriteKeyword:                "delay_key_write",
+	token.DelayedKeyword:                      "delayed",
+	token.DeleteKeyword:                       "delete",
+	token.DenseRankKeyword:                    "dense_rank",
+	token.DescKeyword:                         "desc",
+	token.DescribeKeyword:                     "describe",
+	token.DescriptionKeyword:                  "description",
+	token.DeterministicKeyword:                "deterministic",
+	token.DiagnosticsKeyword:                  "diagnostics",
+	token.DirectoryKeyword:                    "directory",
+	token.DisableKeyword:                      "disable",
+	token.DiscardKeyword:                      "discard",
+	token.DiskKeyword:                         "disk",
+	token.DistinctKeyword:                     "distinct",
+	token.DistinctrowKeyword:                  "distinctrow",
+	token.DivKeyword:                          "div",
+	token.DoKeyword:                           "do",
+	token.DoubleKeyword:                       "double",
+	token.DropKeyword:                         "drop",
+	token.DualKeyword:                         "dual",
+	token.DumpfileKeyword:                     "dumpfile",
+	token.DuplicateKeyword:                    "duplicate",
+	token.DynamicKeyword:                      "dynamic",
+	token.EachKeyword:                         "each",
+	token.ElseKeyword:                         "else",
+	token.ElseifKeyword:                       "elseif",
+	token.EmptyKeyword:                        "empty",
+	token.EnableKeyword:                       "enable",
+	token.EnclosedKeyword:                     "enclosed",
+	token.EncryptionKeyword:                   "encryption",
+	token.EndKeyword:                          "end",
+	token.EndsKeyword:                         "ends",
+	token.EnforcedKeyword:                     "enforced",
+	token.EngineKeyword:                       "engine",
+	token.EngineAttributeKeyword:              "engine_attribute",
+	token.EnginesKeyword:                      "engines",
+	token.EnumKeyword:                         "enum",
+	token.ErrorKeyword:                        "error",
+	token.ErrorsKeyword:                       "errors",
+	token.EscapeKeyword:                       "escape",
+	token.EscapedKeyword:                      "escaped",
+	token.EventKeyword:                        "event",
+	token.EventsKeyword:                       "events",
+	token.EveryKeyword:                        "every",
+	token.ExceptKeyword:                       "except",
+	token.ExchangeKeyword:                     "exchange",
+	token.ExcludeKeyword:                      "exclude",
+	token.ExecuteKeyword:                      "execute",
+	token.ExistsKeyword:                       "exists",
+	token.ExitKeyword:                         "exit",
+	token.ExpansionKeyword:                    "expansion",
+	token.ExpireKeyword:                       "expire",
+	token.ExplainKeyword:                      "explain",
+	token.ExportKeyword:                       "export",
+	token.ExtendedKeyword:                     "extended",
+	token.ExtentSizeKeyword:                   "extent_size",
+	token.FactorKeyword:                       "factor",
+	token.FailedLoginAttemptsKeyword:          "failed_login_attempts",
+	token.FalseKeyword:                        "false",
+	token.FastKeyword:                         "fast",
+	token.FaultsKeyword:                       "faults",
+	token.FetchKeyword:                        "fetch",
+	token.FieldsKeyword:                       "fields",
+	token.FileKeyword:                         "file",
+	token.FileBlockSizeKeyword:                "file_block_size",
+	token.FilterKeyword:                       "filter",
+	token.FinishKeyword:                       "finish",
+	token.FirstKeyword:                        "first",
+	token.FirstValueKeyword:                   "first_value",
+	token.FixedKeyword:                        "fixed",
+	token.FloatKeyword:                        "float",
+	token.Float4Keyword:                       "float4",
+	token.Float8Keyword:                       "float8",
+	token.FlushKeyword:                        "flush",
+	token.FollowingKeyword:                    "following",
+	token.FollowsKeyword:                      "follows",
+	token.ForKeyword:                          "for",
+	token.ForceKeyword:                        "force",
+	token.ForeignKeyword:                      "foreign",
+	token.FormatKeyword:                       "format",
+	token.FoundKeyword:                        "found",
+	token.From:                                "from",
+	token.FullKeyword:                         "full",
+	token.FulltextKeyword:                     "fulltext",
+	token.FunctionKeyword:                     "function",
+	token.GeneralKeyword:                      "general",
+	token.GeneratedKeyword:                    "generated",
+	token.GeomcollectionKeyword:               "geomcollection",
+	token.GeometryKeyword:                     "geometry",
+	token.GeometrycollectionKeyword:           "geometrycollection",
+	token.GetKeyword:                          "get",
+	token.GetFormatKeyword:                    "get_format",
+	token.GetMasterPublicKeyKeyword:           "get_master_public_key",
+	token.GetSourcePublicKeyKeyword:           "get_source_public_key",
+	token.GlobalKeyword:                       "global",
+	token.GrantKeyword:                        "grant",
+	token.GrantsKeyword:                       "grants",
+	token.GroupKeyword:                        "group",
+	token.GroupReplicationKeyword:             "group_replication",
+	token.GroupingKeyword:                     "grouping",
+	token.GroupsKeyword:                       "groups",
+	token.GtidOnlyKeyword:                     "gtid_only",
+	token.HandlerKeyword:                      "handler",
+	token.HashKeyword:                         "hash",
+	token.HavingKeyword:                       "having",
+	token.HelpKeyword:                         "help",
+	token.HighPriorityKeyword:                 "high_priority",
+	token.HistogramKeyword:                    "histogram",
+	token.HistoryKeyword:                      "history",
+	token.HostKeyword:                         "host",
+	token.HostsKeyword:                        "hosts",
+	token.HourKeyword:                         "hour",
+	token.HourMicrosecondKeyword:              "hour_microsecond",
+	token.HourMinuteKeyword:                   "hour_minute",
+	token.HourSecondKeyword:                   "hour_second",
+	token.IdentifiedKeyword:                   "identified",
+	token.IfKeyword:                           "if",
+	token.IgnoreKeyword:                       "ignore",
+	token.IgnoreServerIdsKeyword:              "ignore_server_ids",
+	token.ImportKeyword:                       "import",
+	token.InKeyword:                           "in",
+	token.InactiveKeyword:                     "inactive",
+	token.IndexKeyword:                        "index",
+	token.IndexesKeyword:                      "indexes",
+	token.InfileKeyword:                       "infile",
+	token.InitialKeyword:                      "initial",
+	token.InitialSizeKeyword:                  "initial_size",
+	token.InitiateKeyword:                     "initiate",
+	token.InnerKeyword:                        "inner",
+	token.InoutKeyword:                        "inout",
+	token.InsensitiveKeyword:                  "insensitive",
+	token.InsertKeyword:                       "insert",
+	token.InsertMethodKeyword:                 "insert_method",
+	token.InstallKeyword:                      "install",
+	token.InstanceKeyword:                     "instance",
+	token.IntKeyword:                          "int",
+	token.Int1Keyword:                         "int1",
+	token.Int2Keyword:                         "int2",
+	token.Int3Keyword:                         "int3",
+	token.Int4Keyword:                         "int4",
+	token.Int8Keyword:                         "int8",
+	token.IntegerKeyword:                      "integer",
+	token.IntervalKeyword:                     "interval",
+	token.IntoKeyword:                         "into",
+	token.InvisibleKeyword:                    "invisible",
+	token.InvokerKeyword:                      "invoker",
+	token.IoKeyword:                           "io",
+	token.IoAfterGtidsKeyword:                 "io_after_gtids",
+	token.IoBeforeGtidsKeyword:                "io_before_gtids",
+	token.IoThreadKeyword:                     "io_thread",
+	token.IpcKeyword:                          "ipc",
+	token.IsKeyword:                           "is",
+	token.IsolationKeyword:                    "isolation",
+	token.IssuerKeyword:                       "issuer",
+	token.IterateKeyword:                      "iterate",
+	token.JoinKeyword:                         "join",
+	token.JsonKeyword:                         "json",
+	token.JsonTableKeyword:                    "json_table",
+	token.JsonValueKeyword:                    "json_value",
+	token.KeyKeyword:                          "key",
+	token.KeyBlockSizeKeyword:                 "key_block_size",
+	token.KeyringKeyword:                      "keyring",
+	token.KeysKeyword:                         "keys",
+	token.KillKeyword:                         "kill",
+	token.LagKeyword:                          "lag",
+	token.LanguageKeyword:                     "language",
+	token.LastKeyword:                         "last",
+	token.LastValueKeyword:                    "last_value",
+	token.LateralKeyword:                      "lateral",
+	token.LeadKeyword:                         "lead",
+	token.LeadingKeyword:                      "leading",
+	token.LeaveKeyword:                        "leave",
+	token.LeavesKeyword:                       "leaves",
+	token.LeftKeyword:                         "left",
+	token.LessKeyword:                         "less",
+	token.LevelKeyword:                        "level",
+	token.LikeKeyword:                         "like",
+	token.LimitKeyword:                        "limit",
+	token.LinearKeyword:                       "linear",
+	token.LinesKeyword:                        "lines",
+	token.LinestringKeyword:                   "linestring",
+	token.ListKeyword:                         "list",
+	token.LoadKeyword:                         "load",
+	token.LocalKeyword:                        "local",
+	token.LocaltimeKeyword:                    "localtime",
+	token.LocaltimestampKeyword:               "localtimestamp",
+	token.LockKeyword:                         "lock",
+	token.LockedKeyword:                       "locked",
+	token.LocksKeyword:                        "locks",
+	token.LogfileKeyword:                      "logfile",
+	token.LogsKeyword:                         "logs",
+	token.LongKeyword:                         "long",
+	token.LongblobKeyword:                     "longblob",
+	token.LongtextKeyword:                     "longtext",
+	token.LoopKeyword:                         "loop",
+	token.LowPriorityKeyword:                  "low_priority",
+	token.MasterKeyword:                       "master",
+	token.MasterAutoPositionKeyword:           "master_auto_position",
+	token.MasterBindKeyword:                   "master_bind",
+	token.MasterCompressionAlgorithmsKeyword:  "master_compression_algorithms",
+	token.MasterConnectRetryKeyword:           "master_connect_retry",
+	token.MasterDelayKeyword:                  "master_delay",
+	token.MasterHeartbeatPeriodKeyword:        "master_heartbeat_period",
+	token.MasterHostKeyword:                   "master_host",
+	token.MasterLogFileKeyword:                "master_log_file",
+	token.MasterLogPosKeyword:                 "master_log_pos",
+	token.MasterPasswordKeyword:               "master_password",
+	token.MasterPortKeyword:                   "master_port",
+	token.MasterPublicKeyPathKeyword:          "master_public_key_path",
+	token.MasterRetryCountKeyword:             "master_retry_count",
+	token.MasterSslKeyword:                    "master_ssl",
+	token.MasterSslCaKeyword:                  "master_ssl_ca",
+	token.MasterSslCapathKeyword:              "master_ssl_capath",
+	token.MasterSslCertKeyword:                "master_ssl_cert",
+	token.MasterSslCipherKeyword:              "master_ssl_cipher",
+	token.MasterSslCrlKeyword:                 "master_ssl_crl",
+	token.MasterSslCrlpathKeyword:             "master_ssl_crlpath",
+	token.MasterSslKeyKeyword:                 "master_ssl_key",
+	token.MasterSslVerifyServerCertKeyword:    "master_ssl_verify_server_cert",
+	token.MasterTlsCiphersuitesKeyword:        "master_tls_ciphersuites",
+	token.MasterTlsVersionKeyword:             "master_tls_version",
+	token.MasterUserKeyword:                   "master_user",
+	token.MasterZstdCompressionLevelKeyword:   "master_zstd_compression_level",
+	token.MatchKeyword:                        "match",
+	token.MaxConnectionsPerHourKeyword:        "max_connections_per_hour",
+	token.MaxQueriesPerHourKeyword:            "max_queries_per_hour",
+	token.MaxRowsKeyword:                      "max_rows",
+	token.MaxSizeKeyword:                      "max_size",
+	token.MaxUpdatesPerHourKeyword:            "max_updates_per_hour",
+	token.MaxUserConnectionsKeyword:           "max_user_connections",
+	token.MaxvalueKeyword:                     "maxvalue",
+	token.MediumKeyword:                       "medium",
+	token.MediumblobKeyword:                   "mediumblob",
+	token.MediumintKeyword:                    "mediumint",
+	token.MediumtextKeyword:                   "mediumtext",
+	token.MemberKeyword:                       "member",
+	token.MemoryKeyword:                       "memory",
+	token.MergeKeyword:                        "merge",
+	token.MessageTextKeyword:                  "message_text",
+	token.MicrosecondKeyword:                  "microsecond",
+	token.MiddleintKeyword:                    "middleint",
+	token.MigrateKeyword:                      "migrate",
+	token.MinRowsKeyword:                      "min_rows",
+	token.MinuteKeyword:                       "minute",
+	token.MinuteMicrosecondKeyword:            "minute_microsecond",
+	token.MinuteSecondKeyword:                 "minute_second",
+	token.ModKeyword:                          "mod",
+	token.ModeKeyword:                         "mode",
+	token.ModifiesKeyword:                     "modifies",
+	token.ModifyKeyword:                       "modify",
+	token.MonthKeyword:                        "month",
+	token.MultilinestringKeyword:              "multilinestring",
+	token.MultipointKeyword:                   "multipoint",
+	token.MultipolygonKeyword:                 "multipolygon",
+	token.MutexKeyword:                        "mutex",
+	token.MysqlErrnoKeyword:                   "mysql_errno",
+	token.NameKeyword:                         "name",
+	token.NamesKeyword:                        "names",
+	token.NationalKeyword:                     "national",
+	token.NaturalKeyword:                      "natural",
+	token.NcharKeyword:                        "nchar",
+	token.NdbKeyword:                          "ndb",
+	token.NdbclusterKeyword:                   "ndbcluster",
+	token.NestedKeyword:                       "nested",
+	token.NetworkNamespaceKeyword:             "network_namespace",
+	token.NeverKeyword:                        "never",
+	token.NewKeyword:                          "new",
+	token.NextKeyword:                         "next",
+	token.NoKeyword:                           "no",
+	token.NoWaitKeyword:                       "no_wait",
+	token.NoWriteToBinlogKeyword:              "no_write_to_binlog",
+	token.NodegroupKeyword:                    "nodegroup",
+	token.NoneKeyword:                         "none",
+	token.NotKeyword:                          "not",
+	token.NowaitKeyword:                       "nowait",
+	token.NthValueKeyword:                     "nth_value",
+	token.NtileKeyword:                        "ntile",
+	token.NullKeyword:                         "null",
+	token.NullsKeyword:                        "nulls",
+	token.NumberKeyword:                       "number",
+	token.NumericKeyword:                      "numeric",
+	token.NvarcharKeyword:                     "nvarchar",
+	token.OfKeyword:                           "of",
+	token.OffKeyword:                          "off",
+	token.OffsetKeyword:                       "offset",
+	token.OjKeyword:                           "oj",
+	token.OldKeyword:                          "old",
+	token.OnKeyword:                           "on",
+	token.OneKeyword:                          "one",
+	token.OnlyKeyword:                         "only",
+	token.OpenKeyword:                         "open",
+	token.OptimizeKeyword:                     "optimize",
+	token.OptimizerCostsKeyword:               "optimizer_costs",
+	token.OptionKeyword:                       "option",
+	token.OptionalKeyword:                     "optional",
+	token.OptionallyKeyword:                   "optionally",
+	token.OptionsKeyword:                      "options",
+	token.Or:                                  "or",
+	token.OrderKeyword:                        "order",
+	token.OrdinalityKeyword:                   "ordinality",
+	token.OrganizationKeyword:                 "organization",
+	token.OthersKeyword:                       "others",
+	token.OutKeyword:                          "out",
+	token.OuterKeyword:                        "outer",
+	token.OutfileKeyword:                      "outfile",
+	token.OverKeyword:                         "over",
+	token.OwnerKeyword:                        "owner",
+	token.PackKeysKeyword:                     "pack_keys",
+	token.PageKeyword:                         "page",
+	token.ParserKeyword:                       "parser",
+	token.PartialKeyword:                      "partial",
+	token.PartitionKeyword:                    "partition",
+	token.PartitioningKeyword:                 "partitioning",
+	token.PartitionsKeyword:                   "partitions",
+	token.PasswordKeyword:                     "password",
+	token.PasswordLockTimeKeyword:             "password_lock_time",
+	token.PathKeyword:                         "path",
+	token.PercentRankKeyword:                  "percent_rank",
+	token.PersistKeyword:                      "persist",
+	token.PersistOnlyKeyword:                  "persist_only",
+	token.PhaseKeyword:                        "phase",
+	token.PluginKeyword:                       "plugin",
+	token.PluginDirKeyword:                    "plugin_dir",
+	token.PluginsKeyword:                      "plugins",
+	token.PointKeyword:                        "point",
+	token.PolygonKeyword:                      "polygon",
+	token.PortKeyword:                         "port",
+	token.PrecedesKeyword:                     "precedes",
+	token.PrecedingKeyword:                    "preceding",
+	token.PrecisionKeyword:                    "precision",
+	token.PrepareKeyword:                      "prepare",
+	token.PreserveKeyword:                     "preserve",
+	token.PrevKeyword:                         "prev",
+	token.PrimaryKeyword:                      "primary",
+	token.PrivilegeChecksUserKeyword:          "privilege_checks_user",
+	token.PrivilegesKeyword:                   "privileges",
+	token.ProcedureKeyword:                    "procedure",
+	token.ProcessKeyword:                      "process",
+	token.ProcesslistKeyword:                  "processlist",
+	token.ProfileKeyword:                      "profile",
+	token.ProfilesKeyword:                     "profiles",
+	token.ProxyKeyword:                        "proxy",
+	token.PurgeKeyword:                        "purge",
+	token.QuarterKeyword:                      "quarter",
+	token.QueryKeyword:                        "query",
+	token.QuickKeyword:                        "quick",
+	token.RandomKeyword:                       "random",
+	token.RangeKeyword:                        "range",
+	token.RankKeyword:                         "rank",
+	token.ReadKeyword:                         "read",
+	token.ReadOnlyKeyword:                     "read_only",
+	token.ReadWriteKeyword:                    "read_write",
+	token.ReadsKeyword:                        "reads",
+	token.RealKeyword:                         "real",
+	token.RebuildKeyword:                      "rebuild",
+	token.RecoverKeyword:                      "recover",
+	token.RecursiveKeyword:                    "recursive",
+	token.RedoBufferSizeKeyword:               "redo_buffer_size",
+	token.RedundantKeyword:                    "redundant",
+	token.ReferenceKeyword:                    "reference",
+	token.ReferencesKeyword:                   "references",
+	token.RegexpKeyword:                       "regexp",
+	token.RegistrationKeyword:                 "registration",
+	token.RelayKeyword:                        "relay",
+	token.RelayLogFileKeyword:                 "relay_log_file",
+	token.RelayLogPosKeyword:                  "relay_log_pos",
+	token.RelayThreadKeyword:                  "relay_thread",
+	token.RelaylogKeyword:                     "relaylog",
+	token.ReleaseKeyword:                      "release",
+	token.ReloadKeyword:                       "reload",
+	token.RemoveKeyword:                       "remove",
+	token.RenameKeyword:                       "rename",
+	token.ReorganizeKeyword:                   "reorganize",
+	token.RepairKeyword:                       "repair",
+	token.RepeatKeyword:                       "repeat",
+	token.RepeatableKeyword:                   "repeatable",
+	token.ReplaceKeyword:                      "replace",
+	token.ReplicaKeyword:                      "replica",
+	token.ReplicasKeyword:                     "replicas",
+	token.ReplicateDoDbKeyword:                "replicate_do_db",
+	token.ReplicateDoTableKeyword:             "replicate_do_table",
+	token.ReplicateIgnoreDbKeyword:            "replicate_ignore_db",
+	token.ReplicateIgnoreTableKeyword:         "replicate_ignore_table",
+	token.ReplicateRewriteDbKeyword:           "replicate_rewrite_db",
+	token.ReplicateWildDoTableKeyword:         "replicate_wild_do_table",
+	token.ReplicateWildIgnoreTableKeyword:     "replicate_wild_ignore_table",
+	token.ReplicationKeyword:                  "replication",
+	token.RequireKeyword:                      "require",
+	token.RequireRowFormatKeyword:             "require_row_format",
+	token.RequireTablePrimaryKeyCheckKeyword:  "require_table_primary_key_check",
+	token.ResetKeyword:                        "reset",
+	token.ResignalKeyword:                     "resignal",
+	token.ResourceKeyword:                     "resource",
+	token.RespectKeyword:                      "respect",
+	token.RestartKeyword:                      "restart",
+	token.RestoreKeyword:                      "restore",
+	token.RestrictKeyword:                     "restrict",
+	token.ResumeKeyword:                       "resume",
+	token.RetainKeyword:                       "retain",
+	token.ReturnKeyword:                       "return",
+	token.ReturnedSqlstateKeyword:             "returned_sqlstate",
+	token.ReturningKeyword:                    "returning",
+	token.ReturnsKeyword:                      "returns",
+	token.ReuseKeyword:                        "reuse",
+	token.ReverseKeyword:                      "reverse",
+	token.RevokeKeyword:                       "revoke",
+	token.RightKeyword:                        "right",
+	token.RlikeKeyword:                        "rlike",
+	token.RoleKeyword:                         "role",
+	token.RollbackKeyword:                     "rollback",
+	token.RollupKeyword:                       "rollup",
+	token.RotateKeyword:                       "rotate",
+	token.RoutineKeyword:                      "routine",
+	token.RowKeyword:                          "row",
+	token.RowCountKeyword:                     "row_count",
+	token.RowFormatKeyword:                    "row_format",
+	token.RowNumberKeyword:                    "row_number",
+	token.RowsKeyword:                         "rows",
+	token.RtreeKeyword:                        "rtree",
+	token.SavepointKeyword:                    "savepoint",
+	token.ScheduleKeyword:                     "schedule",
+	token.SchemaKeyword:                       "schema",
+	token.SchemaNameKeyword:                   "schema_name",
+	token.SchemasKeyword:                      "schemas",
+	token.SecondKeyword:                       "second",
+	token.SecondMicrosecondKeyword:            "second_microsecond",
+	token.SecondaryKeyword:                    "secondary",
+	token.SecondaryEngineKeyword:              "secondary_engine",
+	token.SecondaryEngineAttributeKeyword:     "secondary_engine_attribute",
+	token.SecondaryLoadKeyword:                "secondary_load",
+	token.SecondaryUnloadKeyword:              "secondary_unload",
+	token.SecurityKeyword:                     "security",
+	token.Select:                              "select",
+	token.SensitiveKeyword:                    "sensitive",
+	token.SeparatorKeyword:                    "separator",
+	token.SerialKeyword:                       "serial",
+	token.SerializableKeyword:                 "serializable",
+	token.ServerKeyword:                       "server",
+	token.SessionKeyword:                      "session",
+	token.SetKeyword:                          "set",
+	token.ShareKeyword:                        "share",
+	token.ShowKeyword:                         "show",
+	token.ShutdownKeyword:                     "shutdown",
+	token.SignalKeyword:                       "signal",
+	token.SignedKeyword:                       "signed",
+	token.SimpleKeyword:                       "simple",
+	token.SkipKeyword:                         "skip",
+	token.SlaveKeyword:                        "slave",
+	token.SlowKeyword:                         "slow",
+	token.SmallintKeyword:                     "smallint",
+	token.SnapshotKeyword:                     "snapshot",
+	token.SocketKeyword:                       "socket",
+	token.SomeKeyword:                         "some",
+	token.SonameKeyword:                       "soname",
+	token.SoundsKeyword:                       "sounds",
+	token.SourceKeyword:                       "source",
+	token.SourceAutoPositionKeyword:           "source_auto_position",
+	token.SourceBindKeyword:                   "source_bind",
+	token.SourceCompressionAlgorithmsKeyword:  "source_compression_algorithms",
+	token.SourceConnectRetryKeyword:           "source_connect_retry",
+	token.SourceConnectionAutoFailoverKeyword: "source_connection_auto_failover",
+	token.SourceDelayKeyword:                  "source_delay",
+	token.SourceHeartbeatPeriodKeyword:        "source_heartbeat_period",
+	token.SourceHostKeyword:                   "source_host",
+	token.SourceLogFileKeyword:                "source_log_file",
+	token.SourceLogPosKeyword:                 "source_log_pos",
+	token.SourcePasswordKeyword:               "source_password",
+	token.SourcePortKeyword:                   "source_port",
+	token.SourcePublicKeyPathKeyword:          "source_public_key_path",
+	token.SourceRetryCountKeyword:             "source_retry_count",
+	token.SourceSslKeyword:                    "source_ssl",
+	token.SourceSslCaKeyword:                  "source_ssl_ca",
+	token.SourceSslCapathKeyword:              "source_ssl_capath",
+	token.SourceSslCertKeyword:                "source_ssl_cert",
+	token.SourceSslCipherKeyword:              "source_ssl_cipher",
+	token.SourceSslCrlKeyword:                 "source_ssl_crl",
+	token.SourceSslCrlpathKeyword:             "source_ssl_crlpath",
+	token.SourceSslKeyKeyword:                 "source_ssl_key",
+	token.SourceSslVerifyServerCertKeyword:    "source_ssl_verify_server_cert",
+	token.SourceTlsCiphersuitesKeyword:        "source_tls_ciphersuites",
+	token.SourceTlsVersionKeyword:             "source_tls_version",
+	token.SourceUserKeyword:                   "source_user",
+	token.SourceZstdCompressionLevelKeyword:   "source_zstd_compression_level",
+	token.SpatialKeyword:                      "spatial",
+	token.SpecificKeyword:                     "specific",
+	token.SqlKeyword:                          "sql",
+	token.SqlAfterGtidsKeyword:                "sql_after_gtids",
+	token.SqlAfterMtsGapsKeyword:              "sql_after_mts_gaps",
+	token.SqlBeforeGtidsKeyword:               "sql_before_gtids",
+	token.SqlBigResultKeyword:                 "sql_big_result",
+	token.SqlBufferResultKeyword:              "sql_buffer_result",
+	token.SqlCalcFoundRowsKeyword:             "sql_calc_found_rows",
+	token.SqlNoCacheKeyword:                   "sql_no_cache",
+	token.SqlSmallResultKeyword:               "sql_small_result",
+	token.SqlThreadKeyword:                    "sql_thread",
+	token.SqlTsiDayKeyword:                    "sql_tsi_day",
+	token.SqlTsiHourKeyword:                   "sql_tsi_hour",
+	token.SqlTsiMinuteKeyword:                 "sql_tsi_minute",
+	token.SqlTsiMonthKeyword:                  "sql_tsi_month",
+	token.SqlTsiQuarterKeyword:                "sql_tsi_quarter",
+	token.SqlTsiSecondKeyword:                 "sql_tsi_second",
+	token.SqlTsiWeekKeyword:                   "sql_tsi_week",
+	token.SqlTsiYearKeyword:                   "sql_tsi_year",
+	token.SqlexceptionKeyword:                 "sqlexception",
+	token.SqlstateKeyword:                     "sqlstate",
+	token.SqlwarningKeyword:                   "sqlwarning",
+	token.SridKeyword:                         "srid",
+	token.SslKeyword:                          "ssl",
+	token.StackedKeyword:                      "stacked",
+	token.StartKeyword:                        "start",
+	token.StartingKeyword:                     "starting",
+	token.StartsKeyword:                       "starts",
+	token.StatsAutoRecalcKeyword:              "stats_auto_recalc",
+	token.StatsPersistentKeyword:              "stats_persistent",
+	token.StatsSamplePagesKeyword:             "stats_sample_pages",
+	token.StatusKeyword:                       "status",
+	token.StopKeyword:                         "stop",
+	token.StorageKeyword:                      "storage",
+	token.StoredKeyword:                       "stored",
+	token.StraightJoinKeyword:                 "straight_join",
+	token.StreamKeyword:                       "stream",
+	token.StringKeyword:                       "string",
+	token.SubclassOriginKeyword:               "subclass_origin",
+	token.SubjectKeyword:                      "subject",
+	token.SubpartitionKeyword:                 "subpartition",
+	token.SubpartitionsKeyword:                "subpartitions",
+	token.SuperKeyword:                        "super",
+	token.SuspendKeyword:                      "suspend",
+	token.SwapsKeyword:                        "swaps",
+	token.SwitchesKeyword:                     "switches",
+	token.SystemKeyword:                       "system",
+	token.TableKeyword:                        "table",
+	token.TableChecksumKeyword:                "table_checksum",
+	token.TableNameKeyword:                    "table_name",
+	token.TablesKeyword:                       "tables",
+	token.TablespaceKeyword:                   "tablespace",
+	token.TemporaryKeyword:                    "temporary",
+	token.TemptableKeyword:                    "temptable",
+	token.TerminatedKeyword:                   "terminated",
+	token.TextKeyword:                         "text",
+	token.ThanKeyword:                         "than",
+	token.ThenKeyword:                         "then",
+	token.ThreadPriorityKeyword:               "thread_priority",
+	token.TiesKeyword:                         "ties",
+	token.TimeKeyword:                         "time",
+	token.TimestampKeyword:                    "timestamp",
+	token.TimestampaddKeyword:                 "timestampadd",
+	token.TimestampdiffKeyword:                "timestampdiff",
+	token.TinyblobKeyword:                     "tinyblob",
+	token.TinyintKeyword:                      "tinyint",
+	token.TinytextKeyword:                     "tinytext",
+	token.TlsKeyword:                          "tls",
+	token.ToKeyword:                           "to",
+	token.TrailingKeyword:                     "trailing",
+	token.TransactionKeyword:                  "transaction",
+	token.TriggerKeyword:                      "trigger",
+	token.TriggersKeyword:                     "triggers",
+	token.TrueKeyword:                         "true",
+	token.TruncateKeyword:                     "truncate",
+	token.TypeKeyword:                         "type",
+	token.TypesKeyword:                        "types",
+	token.UnboundedKeyword:                    "unbounded",
+	token.UncommittedKeyword:                  "uncommitted",
+	token.UndefinedKeyword:                    "undefined",
+	token.UndoKeyword:                         "undo",
+	token.UndoBufferSizeKeyword:               "undo_buffer_size",
+	token.UndofileKeyword:                     "undofile",
+	token.UnicodeKeyword:                      "unicode",
+	token.UninstallKeyword:                    "uninstall",
+	token.UnionKeyword:                        "union",
+	token.UniqueKeyword:                       "unique",
+	token.UnknownKeyword:                      "unknown",
+	token.UnlockKeyword:                       "unlock",
+	token.UnregisterKeyword:                   "unregister",
+	token.UnsignedKeyword:                     "unsigned",
+	token.UntilKeyword:                        "until",
+	token.UpdateKeyword:                       "update",
+	token.UpgradeKeyword:                      "upgrade",
+	token.UsageKeyword:                        "usage",
+	token.UseKeyword:                          "use",
+	token.UseFrmKeyword:                       "use_frm",
+	token.UserKeyword:                         "user",
+	token.UserResourcesKeyword:                "user_resources",
+	token.UsingKeyword:                        "using",
+	token.UtcDateKeyword:                      "utc_date",
+	token.UtcTimeKeyword:                      "utc_time",
+	token.UtcTimestampKeyword:                 "utc_timestamp",
+	token.ValidationKeyword:                   "validation",
+	token.ValueKeyword:                        "value",
+	token.ValuesKeyword:                       "values",
+	token.VarbinaryKeyword:                    "varbinary",
+	token.VarcharKeyword:                      "varchar",
+	token.VarcharacterKeyword:                 "varcharacter",
+	token.VariablesKeyword:                    "variables",
+	token.VaryingKeyword:                      "varying",
+	token.VcpuKeyword:                         "vcpu",
+	token.ViewKeyword:                         "view",
+	token.VirtualKeyword:                      "virtual",
+	token.VisibleKeyword:                      "visible",
+	token.WaitKeyword:                         "wait",
+	token.WarningsKeyword:                     "warnings",
+	token.WeekKeyword:                         "week",
+	token.WeightStringKeyword:                 "weight_string",
+	token.WhenKeyword:                         "when",
+	token.Where:                               "where",
+	token.WhileKeyword:                        "while",
+	token.WindowKeyword:                       "window",
+	token.WithKeyword:                         "with",
+	token.WithoutKeyword:                      "without",
+	token.WorkKeyword:                         "work",
+	token.WrapperKeyword:                      "wrapper",
+	token.WriteKeyword:                        "write",
+	token.X509Keyword:                         "x509",
+	token.XaKeyword:                           "xa",
+	token.XidKeyword:                          "xid",
+	token.XmlKeyword:                          "xml",
+	token.XorKeyword:                          "xor",
+	token.YearKeyword:                         "year",
+	token.YearMonthKeyword:                    "year_month",
+	token.ZerofillKeyword:                     "zerofill",
+	token.ZoneKeyword:                         "zone",
+}
@@ -0,0 +1,356 @@
+package lexer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// DefaultMaxStateCount is the default max number of the cached states of the lazy DFA
+	DefaultMaxStateCount = 10000
+)
+
+// lazyState is a cached state of the lazy DFA, it is a set of the NFA states just like the Set of the DFA,
+// but its transitions are computed on first use
+type lazyState struct {
+	states    []*State
+	tokenType token.Type
+	isFinal   bool
+	// next maps the class of the runes to the next state, nil means there is no transition,
+	// so the number of the transitions of a state is bounded by the number of the classes
+	next map[int]*lazyState
+	// generation is the generation of the cache when the state is created
+	generation int
+}
+
+// newLazyState returns a new *lazyState which contains the given NFA states
+func newLazyState(states []*State, generation int) *lazyState {
+	s := &lazyState{
+		states:     states,
+		next:       make(map[int]*lazyState),
+		generation: generation,
+	}
+
+	final := getFinalState(states)
+	if final != nil {
+		s.isFinal = true
+		s.tokenType = final.TokenType
+	}
+
+	return s
+}
+
+// LazyDFA is a DFA of which sets are constructed on demand from the NFA,
+// the runes are grouped into equivalence classes just like the TableDFA, and the transitions are cached by the classes,
+// the constructed sets and transitions are cached until the number of the cached sets reaches the limit,
+// then the cache is flushed, and the walkers which are walking through the flushed sets fall back to the NFA simulation
+type LazyDFA struct {
+	NFA           *NFA
+	MaxStateCount int
+	// asciiClasses are the classes of the ascii runes
+	asciiClasses [asciiSize]int
+	// classRanges are the sorted and disjoint ranges of the NFA transitions, the class of the runes of classRanges[i] is i + 1
+	classRanges []RuneRange
+	mutex       sync.RWMutex
+	cache       map[string]*lazyState
+	initState   *lazyState
+	generation  int
+	flushCount  int
+}
+
+// NewLazyDFA returns a new *LazyDFA of the given NFA,
+// if the max state count is not positive, DefaultMaxStateCount will be used
+func NewLazyDFA(nfa *NFA, maxStateCount int) *LazyDFA {
+	if maxStateCount <= constant.ZeroInt {
+		maxStateCount = DefaultMaxStateCount
+	}
+
+	ld := &LazyDFA{
+		NFA:           nfa,
+		MaxStateCount: maxStateCount,
+	}
+	ld.initClasses()
+	ld.flush()
+
+	return ld
+}
+
+// NewLazyDFAWithDefault returns a new *LazyDFA of the default NFA with the default max state count
+func NewLazyDFAWithDefault() *LazyDFA {
+	return NewLazyDFA(NewNFAWithDefault(), DefaultMaxStateCount)
+}
+
+// initClasses initializes the classes of the runes, the runes of the same split range of the NFA transitions
+// always transit to the same NFA states, so they are in the same class
+func (ld *LazyDFA) initClasses() {
+	var (
+		ranges  []RuneRange
+		states  []*State
+		visited = make(map[int]bool)
+	)
+	visit := func(state *State) {
+		if !visited[state.Index] {
+			visited[state.Index] = true
+			states = append(states, state)
+		}
+	}
+
+	// collect the ranges of the transitions of all the NFA states
+	visit(ld.NFA.InitState)
+	for len(states) > constant.ZeroInt {
+		state := states[len(states)-1]
+		states = states[:len(states)-1]
+		for _, t := range state.Transitions {
+			ranges = append(ranges, t.Range)
+			visit(t.Next)
+		}
+		for _, ns := range state.Epsilons {
+			visit(ns)
+		}
+	}
+
+	ld.classRanges = splitRanges(ranges)
+	for c := range ld.asciiClasses {
+		ld.asciiClasses[c] = searchRange(ld.classRanges, rune(c)) + 1
+	}
+}
+
+// getClass returns the class of the given rune, the runes which never appear in any transition are in noClass
+func (ld *LazyDFA) getClass(c rune) int {
+	if c >= constant.ZeroInt && c < asciiSize {
+		return ld.asciiClasses[c]
+	}
+
+	return searchRange(ld.classRanges, c) + 1
+}
+
+// flush drops all the cached states and starts a new generation, only the init state is cached again
+func (ld *LazyDFA) flush() {
+	if ld.cache != nil {
+		ld.flushCount++
+	}
+	ld.generation++
+
+	states := ld.NFA.getClosure(ld.NFA.InitState)
+	ld.initState = newLazyState(states, ld.generation)
+	ld.cache = map[string]*lazyState{getStatesKey(states): ld.initState}
+}
+
+// getInitState returns the init state of current generation
+func (ld *LazyDFA) getInitState() *lazyState {
+	ld.mutex.RLock()
+	defer ld.mutex.RUnlock()
+
+	return ld.initState
+}
+
+// getNext returns the next state of the given state and rune, the next state is nil if there is no transition,
+// it returns false if the given state has been flushed from the cache or the cache is full,
+// in which case the caller should fall back to the NFA simulation
+func (ld *LazyDFA) getNext(s *lazyState, c rune) (*lazyState, bool) {
+	class := ld.getClass(c)
+	if class == noClass {
+		// no NFA state could transit with the rune
+		return nil, true
+	}
+
+	// the cached transitions are read concurrently
+	ld.mutex.RLock()
+	if s.generation != ld.generation {
+		ld.mutex.RUnlock()
+		return nil, false
+	}
+	ns, ok := s.next[class]
+	ld.mutex.RUnlock()
+	if ok {
+		return ns, true
+	}
+
+	ld.mutex.Lock()
+	defer ld.mutex.Unlock()
+
+	// the transition may be computed or the cache may be flushed by others after the read lock is released
+	if s.generation != ld.generation {
+		return nil, false
+	}
+	ns, ok = s.next[class]
+	if ok {
+		return ns, true
+	}
+
+	states := ld.NFA.step(s.states, c)
+	if len(states) == constant.ZeroInt {
+		s.next[class] = nil
+		return nil, true
+	}
+
+	key := getStatesKey(states)
+	ns, ok = ld.cache[key]
+	if !ok {
+		if len(ld.cache) >= ld.MaxStateCount {
+			ld.flush()
+			return nil, false
+		}
+		ns = newLazyState(states, ld.generation)
+		ld.cache[key] = ns
+	}
+	s.next[class] = ns
+
+	return ns, true
+}
+
+// GetStateCount returns the number of the cached states
+func (ld *LazyDFA) GetStateCount() int {
+	ld.mutex.RLock()
+	defer ld.mutex.RUnlock()
+
+	return len(ld.cache)
+}
+
+// GetFlushCount returns how many times the cache has been flushed
+func (ld *LazyDFA) GetFlushCount() int {
+	ld.mutex.RLock()
+	defer ld.mutex.RUnlock()
+
+	return ld.flushCount
+}
+
+// Print prints the character classes, the cached states and their transitions
+func (ld *LazyDFA) Print() {
+	ld.mutex.RLock()
+	defer ld.mutex.RUnlock()
+
+	for i, r := range ld.classRanges {
+		fmt.Println(fmt.Sprintf("class %d: %s-%s", i+1, strconv.QuoteRune(r.Start), strconv.QuoteRune(r.End)))
+	}
+	fmt.Println(fmt.Sprintf("cached states: %d, max states: %d, flush count: %d", len(ld.cache), ld.MaxStateCount, ld.flushCount))
+	for key, s := range ld.cache {
+		classes := make([]int, constant.ZeroInt, len(s.next))
+		for class := range s.next {
+			classes = append(classes, class)
+		}
+		sort.Ints(classes)
+		for _, class := range classes {
+			if s.next[class] != nil {
+				fmt.Println(fmt.Sprintf("state {%s} + input class %d -> state {%s}", key, class, getStatesKey(s.next[class].states)))
+			}
+		}
+		if s.isFinal {
+			fmt.Println(fmt.Sprintf("final state found. states: {%s}, tokenType: %s", key, s.tokenType.String()))
+		}
+	}
+}
+
+// Match matches the given runes and returns proper token
+func (ld *LazyDFA) Match(runes []rune) *token.Token {
+	w := NewLazyDFAWalker(ld)
+	for _, c := range runes {
+		if !w.Step(c) {
+			return token.NewToken(token.Error, string(runes))
+		}
+	}
+
+	tokenType, ok := w.GetTokenType()
+	if !ok {
+		return token.NewToken(token.Error, string(runes))
+	}
+
+	return token.NewToken(tokenType, string(runes))
+}
+
+// NewWalker returns a new walker of the LazyDFA
+func (ld *LazyDFA) NewWalker() dependency.Walker {
+	return NewLazyDFAWalker(ld)
+}
+
+// LazyDFAWalker walks through the LazyDFA rune by rune
+type LazyDFAWalker struct {
+	ld    *LazyDFA
+	state *lazyState
+	// states are the NFA states when the walker falls back to the NFA simulation
+	states []*State
+}
+
+// NewLazyDFAWalker returns a new *LazyDFAWalker
+func NewLazyDFAWalker(ld *LazyDFA) *LazyDFAWalker {
+	w := &LazyDFAWalker{
+		ld: ld,
+	}
+	w.Reset()
+
+	return w
+}
+
+// Reset resets the walker to the init state of current generation of the cache
+func (w *LazyDFAWalker) Reset() {
+	w.state = w.ld.getInitState()
+	w.states = nil
+}
+
+// Step transits to the next state with the given rune,
+// if the cache is flushed, the walker falls back to the NFA simulation until it is reset
+func (w *LazyDFAWalker) Step(c rune) bool {
+	if w.state != nil {
+		next, ok := w.ld.getNext(w.state, c)
+		if ok {
+			if next == nil {
+				return false
+			}
+			w.state = next
+
+			return true
+		}
+		// the cache is flushed, continue with the NFA states
+		w.states = w.state.states
+		w.state = nil
+	}
+
+	next := w.ld.NFA.step(w.states, c)
+	if len(next) == constant.ZeroInt {
+		return false
+	}
+	w.states = next
+
+	return true
+}
+
+// GetTokenType returns the token type of current state
+func (w *LazyDFAWalker) GetTokenType() (token.Type, bool) {
+	if w.state != nil {
+		if !w.state.isFinal {
+			return token.Error, false
+		}
+
+		return w.state.tokenType, true
+	}
+
+	final := getFinalState(w.states)
+	if final == nil {
+		return token.Error, false
+	}
+
+	return final.TokenType, true
+}
+
+// getStatesKey returns the key of the NFA states, the same states always have the same key regardless of the order
+func getStatesKey(states []*State) string {
+	indexes := make([]int, len(states))
+	for i, s := range states {
+		indexes[i] = s.Index
+	}
+	sort.Ints(indexes)
+
+	strs := make([]string, len(indexes))
+	for i, index := range indexes {
+		strs[i] = strconv.Itoa(index)
+	}
+
+	return strings.Join(strs, constant.CommaString)
+}
@@ -0,0 +1,117 @@
+package lexer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testLazyDFA      *LazyDFA
+	testLazyDFALexer *Lexer
+)
+
+func init() {
+	initTestLazyDFA()
+}
+
+func initTestLazyDFA() {
+	testLazyDFA = NewLazyDFAWithDefault()
+	testLazyDFALexer = NewLexer(testLazyDFA)
+}
+
+func TestLazyDFA_All(t *testing.T) {
+	TestLazyDFA_Match(t)
+	TestLazyDFA_Lex(t)
+	TestLazyDFA_Flush(t)
+	TestLazyDFA_Transitions(t)
+	TestLazyDFA_Concurrent(t)
+	TestLazyDFA_Print(t)
+}
+
+func TestLazyDFA_Match(t *testing.T) {
+	asst := assert.New(t)
+
+	inputs := getTestInputs([]rune("selctanwhrom1_' <>=!,;"), 3)
+	inputs = append(inputs, "select", "from", "where", "selectt", "'abc123_'", "123abc", "123.", "é", "中文", "<=>", "->>")
+	for _, input := range inputs {
+		asst.Equal(testDFA.Match([]rune(input)), testLazyDFA.Match([]rune(input)), "test Match() failed. input: %s", input)
+	}
+	asst.Equal(0, testLazyDFA.GetFlushCount(), "test Match() failed")
+	asst.LessOrEqual(testLazyDFA.GetStateCount(), testLazyDFA.MaxStateCount, "test Match() failed")
+}
+
+func TestLazyDFA_Lex(t *testing.T) {
+	asst := assert.New(t)
+
+	asst.Equal(testDFALexer.Lex(testBenchmarkSQL), testLazyDFALexer.Lex(testBenchmarkSQL), "test Lex() failed. sql: %s", testBenchmarkSQL)
+}
+
+func TestLazyDFA_Flush(t *testing.T) {
+	asst := assert.New(t)
+
+	// the cache is too small to hold the states of a keyword, so it is flushed again and again
+	for _, maxStateCount := range []int{1, 2, 5} {
+		ld := NewLazyDFA(testLazyDFA.NFA, maxStateCount)
+		for _, input := range []string{"select", "selectt", "from", "'abc123_'", "123.", "<=>", "s", ""} {
+			asst.Equal(testDFA.Match([]rune(input)), ld.Match([]rune(input)), "test Flush failed. max state count: %d, input: %s", maxStateCount, input)
+		}
+		asst.Equal(testDFALexer.Lex(testBenchmarkSQL), NewLexer(ld).Lex(testBenchmarkSQL), "test Flush failed. max state count: %d", maxStateCount)
+		asst.Greater(ld.GetFlushCount(), 0, "test Flush failed. max state count: %d", maxStateCount)
+		asst.LessOrEqual(ld.GetStateCount(), maxStateCount, "test Flush failed. max state count: %d", maxStateCount)
+	}
+
+	asst.Equal(DefaultMaxStateCount, NewLazyDFA(testLazyDFA.NFA, 0).MaxStateCount, "test Flush failed")
+}
+
+func TestLazyDFA_Transitions(t *testing.T) {
+	asst := assert.New(t)
+
+	// the transitions are cached by the classes, so the distinct runes of the same class share the same transition
+	ld := NewLazyDFA(testLazyDFA.NFA, DefaultMaxStateCount)
+	runes := []rune{'a'}
+	for c := rune(0x4e00); c < 0x4e00+5000; c++ {
+		runes = append(runes, c)
+	}
+	asst.Equal(testDFA.Match(runes), ld.Match(runes), "test Transitions failed")
+	asst.Equal(testDFA.Match([]rune("a\U0001F600")), ld.Match([]rune("a\U0001F600")), "test Transitions failed")
+
+	transitionCount := 0
+	for _, s := range ld.cache {
+		transitionCount += len(s.next)
+	}
+	asst.LessOrEqual(transitionCount, 10, "test Transitions failed")
+}
+
+func TestLazyDFA_Concurrent(t *testing.T) {
+	asst := assert.New(t)
+
+	expected := testDFALexer.Lex(testBenchmarkSQL)
+	l := NewLexer(NewLazyDFA(testLazyDFA.NFA, 8))
+
+	var wg sync.WaitGroup
+	results := make([]bool, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = assert.ObjectsAreEqual(expected, l.Lex(testBenchmarkSQL))
+		}(i)
+	}
+	wg.Wait()
+
+	for i, ok := range results {
+		asst.True(ok, "test Concurrent failed. goroutine: %d", i)
+	}
+}
+
+func TestLazyDFA_Print(t *testing.T) {
+	ld := NewLazyDFA(testLazyDFA.NFA, DefaultMaxStateCount)
+	ld.Match([]rune("select"))
+	ld.Print()
+}
+
+func BenchmarkLazyDFA_Lex(b *testing.B) {
+	benchmarkLex(b, testLazyDFALexer)
+}
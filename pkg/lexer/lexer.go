@@ -1,18 +1,28 @@
 package lexer
 
 import (
+	"io"
+	"strings"
+
 	"github.com/romberli/sql-parser-go/pkg/dependency"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
 type Lexer struct {
-	fa dependency.Lexer
+	fa            dependency.Lexer
+	skipComments  bool
+	serverVersion int
+	lossless      bool
+	// modeRules are the rules which push the modes from the sql mode, e.g. the rule of the optimizer hint
+	modeRules []*ModeRule
 }
 
-// NewLexer returns a new *Lexer
+// NewLexer returns a new *Lexer, comments are skipped and the executable comments are executed on DefaultServerVersion by default
 func NewLexer(fa dependency.Lexer) *Lexer {
 	return &Lexer{
-		fa: fa,
+		fa:            fa,
+		skipComments:  true,
+		serverVersion: DefaultServerVersion,
 	}
 }
 
@@ -21,81 +31,85 @@ func (l *Lexer) GetFiniteAutomata() dependency.Lexer {
 	return l.fa
 }
 
+// SetSkipComments sets whether the comments are skipped
+func (l *Lexer) SetSkipComments(skipComments bool) {
+	l.skipComments = skipComments
+}
+
+// SetServerVersion sets the server version, e.g. 80000 means 8.0.0,
+// the executable comment like /*!80000 ... */ is executed only if its version is not larger than the server version
+func (l *Lexer) SetServerVersion(serverVersion int) {
+	l.serverVersion = serverVersion
+}
+
+// SetLossless sets whether the lexer works in lossless mode,
+// in lossless mode, the white spaces and the comments are attached to the tokens as the trivia,
+// and the last token is always an end token which holds the trivia after the last sql token
+func (l *Lexer) SetLossless(lossless bool) {
+	l.lossless = lossless
+}
+
+// AddModeRule adds a rule which pushes a mode from the sql mode, e.g. NewHintRule() makes the optimizer hints be scanned in the hint mode
+func (l *Lexer) AddModeRule(rule *ModeRule) {
+	l.modeRules = append(l.modeRules, rule)
+}
+
+// NewScanner returns a new *Scanner which scans the input of the given reader with the finite automata of the lexer
+func (l *Lexer) NewScanner(r io.Reader) *Scanner {
+	s := NewScanner(l.GetFiniteAutomata(), r)
+	s.SetSkipComments(l.skipComments)
+	s.SetServerVersion(l.serverVersion)
+	s.SetLossless(l.lossless)
+	for _, rule := range l.modeRules {
+		s.AddModeRule(rule)
+	}
+
+	return s
+}
+
+// NewRawScanner returns a new *RawScanner with the finite automata of the lexer, the lossless mode does not take effect on it
+func (l *Lexer) NewRawScanner() *RawScanner {
+	s := NewRawScanner(l.GetFiniteAutomata())
+	s.SetSkipComments(l.skipComments)
+	s.SetServerVersion(l.serverVersion)
+	for _, rule := range l.modeRules {
+		s.AddModeRule(rule)
+	}
+
+	return s
+}
+
+// LexRaw scans the input string, appends the raw tokens to the given slice and returns the extended slice,
+// to avoid allocating for each input, reuse the scanner returned by NewRawScanner() and the returned slice instead
+func (l *Lexer) LexRaw(sql string, tokens []RawToken) []RawToken {
+	return l.NewRawScanner().Lex(sql, tokens)
+}
+
 // Lex scans the input string and returns a token list
 func (l *Lexer) Lex(sql string) []*token.Token {
-	var (
-		isStringLiteral bool
-		runes           []rune
-		tokens          []*token.Token
-	)
-
-	sqlRunes := []rune(sql)
-	length := len(sqlRunes)
-
-	for i, c := range sqlRunes {
-		if c == singleQuote && !isStringLiteral {
-			// string literal starts
-			isStringLiteral = true
-			runes = append(runes, c)
-			continue
-		}
-
-		if c == singleQuote && isStringLiteral {
-			// string literal ends
-			isStringLiteral = false
-			runes = append(runes, c)
-			// match string literal token
-			tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-			runes = nil
-			continue
-		}
+	if l.lossless {
+		tokens, _ := l.LexWithDiagnostics(sql)
+		return tokens
+	}
 
-		if isStringLiteral {
-			runes = append(runes, c)
-			if i == length-1 {
-				// string literal does not end with single quote
-				tokens = append(tokens, token.NewToken(token.Error, string(runes)))
-			}
-			continue
-		}
+	// the tokens are built on top of the raw tokens
+	return NewTokens(sql, l.LexRaw(sql, nil))
+}
 
-		if IsWhiteSpace(c) {
-			continue
-		}
+// LexWithDiagnostics scans the input string and returns a token list with the diagnostics of all the error tokens,
+// the lexer keeps scanning after the invalid input, so all the problems of the input are returned at once
+func (l *Lexer) LexWithDiagnostics(sql string) ([]*token.Token, []*Diagnostic) {
+	var tokens []*token.Token
 
-		switch c {
-		case GTRune:
-			runes = append(runes, c)
-			if i >= length-1 || sqlRunes[i+1] != EqualRune {
-				tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-				runes = nil
-			}
-		case LTRune:
-			runes = append(runes, c)
-			if i >= length-1 || (sqlRunes[i+1] != EqualRune && sqlRunes[i+1] != GTRune) {
-				tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-				runes = nil
-			}
-		case ExclamationRune:
-			runes = append(runes, c)
-			if i >= length-1 || sqlRunes[i+1] != EqualRune {
-				tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-				runes = nil
-			}
-		case EqualRune, PlusRune, MinusRune, MultiplyRune, DivideRune, LeftParenthesisRune, RightParenthesisRune,
-			SemicolonRune, CommaRune:
-			runes = append(runes, c)
-			tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-			runes = nil
-		default:
-			// match tokens that contains multi runes
-			runes = append(runes, c)
-			if i >= length-1 || !IsAlphabetOrDigit(sqlRunes[i+1]) {
-				tokens = append(tokens, l.GetFiniteAutomata().Match(runes))
-				runes = nil
-			}
+	s := l.NewScanner(strings.NewReader(sql))
+	for {
+		// reading from a string never fails, so the error is always io.EOF
+		t, err := s.Next()
+		if err != nil {
+			break
 		}
+		tokens = append(tokens, t)
 	}
 
-	return tokens
+	return tokens, s.GetDiagnostics()
 }
@@ -2,7 +2,12 @@ package lexer
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -14,11 +19,24 @@ func init() {
 	initTestNFA()
 	testNFALexer = NewLexer(testNFA)
 	initTestDFA()
-	testDFALexer = NewLexer(testNFA)
+	testDFALexer = NewLexer(testDFA)
 }
 
 func TestLexer_All(t *testing.T) {
 	TestLexer_Lex(t)
+	TestLexer_Span(t)
+	TestLexer_LongestMatch(t)
+	TestLexer_CaseInsensitive(t)
+	TestLexer_Keyword(t)
+	TestLexer_Quote(t)
+	TestLexer_Comment(t)
+	TestLexer_ExecutableComment(t)
+	TestLexer_NumberLiteral(t)
+	TestLexer_Unicode(t)
+	TestLexer_Placeholder(t)
+	TestLexer_Lossless(t)
+	TestLexer_Diagnostics(t)
+	TestLexer_Operator(t)
 }
 
 func TestLexer_Lex(t *testing.T) {
@@ -42,3 +60,743 @@ func TestLexer_Lex(t *testing.T) {
 	fmt.Println("==========DFA==========")
 
 }
+
+func TestLexer_Span(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select é\n  from t01"
+	expected := []token.Span{
+		token.NewSpan(token.NewPosition(0, 0, 1, 1), token.NewPosition(6, 6, 1, 7)),
+		token.NewSpan(token.NewPosition(7, 7, 1, 8), token.NewPosition(9, 8, 1, 9)),
+		token.NewSpan(token.NewPosition(12, 11, 2, 3), token.NewPosition(16, 15, 2, 7)),
+		token.NewSpan(token.NewPosition(17, 16, 2, 8), token.NewPosition(20, 19, 2, 11)),
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		tokens := l.Lex(sql)
+		asst.Equal(len(expected), len(tokens), "test Lex() failed")
+		for i, tk := range tokens {
+			if i < len(expected) {
+				asst.Equal(expected[i], tk.GetSpan(), "test Lex() failed")
+				asst.Equal(tk.Lexeme, sql[tk.Span.Start.Offset:tk.Span.End.Offset], "test Lex() failed")
+			}
+		}
+	}
+}
+
+func TestLexer_LongestMatch(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql      string
+		expected []token.Type
+	}{
+		{"a<>b", []token.Type{token.Identifier, token.NotEqual2, token.Identifier}},
+		{"a<=b", []token.Type{token.Identifier, token.LE, token.Identifier}},
+		{"a<b", []token.Type{token.Identifier, token.LT, token.Identifier}},
+		{"a!=b", []token.Type{token.Identifier, token.NotEqual1, token.Identifier}},
+		{"123abc", []token.Type{token.Identifier}},
+		{"123+abc", []token.Type{token.NumberLiteral, token.Plus, token.Identifier}},
+		{"selectt select", []token.Type{token.Identifier, token.Select}},
+		{"as1 as", []token.Type{token.Identifier, token.As}},
+		{"'abc'as", []token.Type{token.StringLiteral, token.As}},
+		{"123.", []token.Type{token.DecimalLiteral}},
+		{"1e10e", []token.Type{token.Identifier}},
+		{"0x1g", []token.Type{token.Identifier}},
+		{"a ! b", []token.Type{token.Identifier, token.LogicalNot, token.Identifier}},
+		{"'abc", []token.Type{token.Error}},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range testCases {
+			var tokenTypes []token.Type
+			for _, tk := range l.Lex(tc.sql) {
+				tokenTypes = append(tokenTypes, tk.Type)
+			}
+			asst.Equal(tc.expected, tokenTypes, "test longest match failed. sql: %s", tc.sql)
+		}
+	}
+}
+
+func TestLexer_CaseInsensitive(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "SELECT Col1, col2 As c2 FROM T01 wHeRe ID >= 1 AND Selected = 'Abc' OR x = 2"
+	expected := []struct {
+		tokenType  token.Type
+		lexeme     string
+		normalized string
+	}{
+		{token.Select, "SELECT", "SELECT"},
+		{token.Identifier, "Col1", "Col1"},
+		{token.Comma, ",", ","},
+		{token.Identifier, "col2", "col2"},
+		{token.As, "As", "AS"},
+		{token.Identifier, "c2", "c2"},
+		{token.From, "FROM", "FROM"},
+		{token.Identifier, "T01", "T01"},
+		{token.Where, "wHeRe", "WHERE"},
+		{token.Identifier, "ID", "ID"},
+		{token.GE, ">=", ">="},
+		{token.NumberLiteral, "1", "1"},
+		{token.And, "AND", "AND"},
+		{token.Identifier, "Selected", "Selected"},
+		{token.Equal, "=", "="},
+		{token.StringLiteral, "'Abc'", "'Abc'"},
+		{token.Or, "OR", "OR"},
+		{token.Identifier, "x", "x"},
+		{token.Equal, "=", "="},
+		{token.NumberLiteral, "2", "2"},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer, NewLexer(NewTableDFAWithDefault())} {
+		tokens := l.Lex(sql)
+		asst.Equal(len(expected), len(tokens), "test Lex() failed")
+		for i, tk := range tokens {
+			if i < len(expected) {
+				asst.Equal(expected[i].tokenType, tk.Type, "test Lex() failed. lexeme: %s", tk.Lexeme)
+				asst.Equal(expected[i].lexeme, tk.Lexeme, "test Lex() failed")
+				asst.Equal(expected[i].normalized, tk.GetNormalizedLexeme(), "test Lex() failed")
+			}
+		}
+	}
+}
+
+func TestLexer_Keyword(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select Status, current_timestamp, `x` from User where Order1 = 1 and Key_Block_Size div 2"
+	expected := []token.Type{
+		token.Select, token.StatusKeyword, token.Comma, token.CurrentTimestampKeyword, token.Comma,
+		token.QuotedIdentifier, token.From, token.UserKeyword, token.Where, token.Identifier, token.Equal,
+		token.NumberLiteral, token.And, token.KeyBlockSizeKeyword, token.DivKeyword, token.NumberLiteral,
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		tokens := l.Lex(sql)
+		tokenTypes := make([]token.Type, len(tokens))
+		for i, tk := range tokens {
+			tokenTypes[i] = tk.Type
+		}
+		asst.Equal(expected, tokenTypes, "test Lex() failed")
+	}
+
+	lookupCases := []struct {
+		word      string
+		tokenType token.Type
+		reserved  bool
+	}{
+		{"select", token.Select, true},
+		{"SELECT", token.Select, true},
+		{"Status", token.StatusKeyword, false},
+		{"order", token.OrderKeyword, true},
+		{"current_timestamp", token.CurrentTimestampKeyword, true},
+		{"col1", token.Identifier, false},
+		{"", token.Identifier, false},
+	}
+	for _, c := range lookupCases {
+		tokenType, reserved := token.LookupKeyword(c.word)
+		asst.Equal(c.tokenType, tokenType, "test LookupKeyword() failed. word: %s", c.word)
+		asst.Equal(c.reserved, reserved, "test LookupKeyword() failed. word: %s", c.word)
+		asst.Equal(c.reserved, tokenType.IsReserved(), "test IsReserved() failed. word: %s", c.word)
+	}
+	asst.Equal(747, len(token.KeywordList), "test KeywordList failed")
+	asst.Equal("statusKeyword", token.StatusKeyword.String(), "test String() failed")
+	asst.Equal(token.Error+1, token.AccessibleKeyword, "test KeywordList failed")
+}
+
+func TestLexer_Quote(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql        string
+		ansiQuotes bool
+		tokenType  token.Type
+		value      interface{}
+	}{
+		{`'hello world'`, false, token.StringLiteral, "hello world"},
+		{`''`, false, token.StringLiteral, ""},
+		{`'it''s'`, false, token.StringLiteral, "it's"},
+		{`'a\'b'`, false, token.StringLiteral, "a'b"},
+		{`'a\\b'`, false, token.StringLiteral, `a\b`},
+		{`'a\nb\tc\0\Z'`, false, token.StringLiteral, "a\nb\tc\x00\x1a"},
+		{`'50\% a\_b \x'`, false, token.StringLiteral, `50\% a\_b x`},
+		{`'a"b'`, false, token.StringLiteral, `a"b`},
+		{"'line1\nline2'", false, token.StringLiteral, "line1\nline2"},
+		{`"abc"`, false, token.StringLiteral, "abc"},
+		{`"a""b\"c"`, false, token.StringLiteral, `a"b"c`},
+		{`"abc"`, true, token.QuotedIdentifier, "abc"},
+		{`"a""b"`, true, token.QuotedIdentifier, `a"b`},
+		{"`order`", false, token.QuotedIdentifier, "order"},
+		{"`a``b c`", false, token.QuotedIdentifier, "a`b c"},
+		{"`a\\b`", true, token.QuotedIdentifier, `a\b`},
+	}
+
+	cs := NewCharacterSetWithDefault()
+	nfa, err := NewNFAWithRules(cs, GetDefaultRulesWithANSIQuotes(cs, true))
+	asst.Nil(err, "test Lex() failed")
+	ansiLexers := []*Lexer{NewLexer(nfa), NewLexer(NewDFAWithNFA(nfa))}
+	for _, tc := range testCases {
+		lexers := []*Lexer{testNFALexer, testDFALexer}
+		if tc.ansiQuotes {
+			lexers = ansiLexers
+		}
+		for _, l := range lexers {
+			tokens := l.Lex(tc.sql)
+			if asst.Equal(1, len(tokens), "test Lex() failed. sql: %s", tc.sql) {
+				asst.Equal(tc.tokenType, tokens[0].Type, "test Lex() failed. sql: %s", tc.sql)
+				asst.Equal(tc.sql, tokens[0].Lexeme, "test Lex() failed. sql: %s", tc.sql)
+				asst.Equal(tc.value, tokens[0].GetValue(), "test Lex() failed. sql: %s", tc.sql)
+			}
+		}
+	}
+
+	// unterminated quotes
+	for _, sql := range []string{`'abc`, `'abc\'`, `"abc`, "`abc"} {
+		for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+			tokens := l.Lex(sql)
+			asst.Equal(token.Error, tokens[len(tokens)-1].Type, "test Lex() failed. sql: %s", sql)
+		}
+	}
+}
+
+// getTokenTypes returns the token types of the given tokens
+func getTokenTypes(tokens []*token.Token) []token.Type {
+	tokenTypes := make([]token.Type, len(tokens))
+	for i, tk := range tokens {
+		tokenTypes[i] = tk.Type
+	}
+
+	return tokenTypes
+}
+
+func TestLexer_Comment(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql      string
+		expected []token.Type
+		comments []string
+	}{
+		{
+			"select 1 -- comment 1\nfrom t # comment 2\n/* comment\n3 */ where a--1",
+			[]token.Type{token.Select, token.NumberLiteral, token.Comment, token.From, token.Identifier, token.Comment,
+				token.Comment, token.Where, token.Identifier, token.Minus, token.Minus, token.NumberLiteral},
+			[]string{"-- comment 1", "# comment 2", "/* comment\n3 */"},
+		},
+		{
+			"--\nselect/**/1/***/#",
+			[]token.Type{token.Comment, token.Select, token.Comment, token.NumberLiteral, token.Comment, token.Comment},
+			[]string{"--\n", "/**/", "/***/", "#"},
+		},
+		{
+			"/* a * b / c **/ select",
+			[]token.Type{token.Comment, token.Select},
+			[]string{"/* a * b / c **/"},
+		},
+		{
+			"select 1 /* unterminated",
+			[]token.Type{token.Select, token.NumberLiteral, token.Error},
+			nil,
+		},
+		{
+			"select 1 /* unterminated *",
+			[]token.Type{token.Select, token.NumberLiteral, token.Error},
+			nil,
+		},
+		// the longest match is kept at the end of the input unless the input ends inside an open quote or block comment
+		{"select a--b", []token.Type{token.Select, token.Identifier, token.Minus, token.Minus, token.Identifier}, nil},
+		{"select a--", []token.Type{token.Select, token.Identifier, token.Comment}, []string{"--"}},
+		{"select 1.5e from t", []token.Type{token.Select, token.DecimalLiteral, token.Identifier, token.From, token.Identifier}, nil},
+		{"select 1.5e", []token.Type{token.Select, token.DecimalLiteral, token.Identifier}, nil},
+		{"select @@ from t", []token.Type{token.Select, token.At, token.At, token.From, token.Identifier}, nil},
+		{"select @@", []token.Type{token.Select, token.At, token.At}, nil},
+		// the -- followed by a control rune or the end of the input is a comment
+		{"select 1 --", []token.Type{token.Select, token.NumberLiteral, token.Comment}, []string{"--"}},
+		{"select 1 --\x01a\nfrom t", []token.Type{token.Select, token.NumberLiteral, token.Comment, token.From, token.Identifier}, []string{"--\x01a"}},
+		{"select 1 --\x7f", []token.Type{token.Select, token.NumberLiteral, token.Comment}, []string{"--\x7f"}},
+		{"select 1 --- a", []token.Type{token.Select, token.NumberLiteral, token.Minus, token.Comment}, []string{"-- a"}},
+		{"select /*!80000 a--*/ b", []token.Type{token.Select, token.Identifier, token.Comment, token.Identifier}, []string{"--"}},
+		{"select 'a", []token.Type{token.Select, token.Error}, nil},
+		{"select 'a''", []token.Type{token.Select, token.Error}, nil},
+		{"select `a", []token.Type{token.Select, token.Error}, nil},
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA} {
+		l := NewLexer(fa)
+		l.SetSkipComments(false)
+		for _, tc := range testCases {
+			tokens := l.Lex(tc.sql)
+			asst.Equal(tc.expected, getTokenTypes(tokens), "test Lex() failed. sql: %s", tc.sql)
+			var comments []string
+			for _, tk := range tokens {
+				if tk.Type == token.Comment {
+					comments = append(comments, tk.Lexeme)
+				}
+			}
+			asst.Equal(tc.comments, comments, "test Lex() failed. sql: %s", tc.sql)
+			tokens, _ = l.LexWithDiagnostics(tc.sql)
+			asst.Equal(tc.expected, getTokenTypes(tokens), "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+		}
+
+		// comments are skipped by default
+		tokens := NewLexer(fa).Lex(testCases[0].sql)
+		for _, tk := range tokens {
+			asst.NotEqual(token.Comment, tk.Type, "test Lex() failed")
+		}
+		asst.Equal(len(testCases[0].expected)-len(testCases[0].comments), len(tokens), "test Lex() failed")
+	}
+}
+
+func TestLexer_ExecutableComment(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql           string
+		serverVersion int
+		expected      []token.Type
+	}{
+		{"select /*! 1, */ 2", DefaultServerVersion, []token.Type{token.Select, token.NumberLiteral, token.Comma, token.NumberLiteral}},
+		{"select /*!80000 1, */ 2", DefaultServerVersion, []token.Type{token.Select, token.NumberLiteral, token.Comma, token.NumberLiteral}},
+		{"select /*!80000 1, */ 2", 80000, []token.Type{token.Select, token.NumberLiteral, token.Comma, token.NumberLiteral}},
+		{"select /*!80000 1, */ 2", 50744, []token.Type{token.Select, token.Comment, token.NumberLiteral}},
+		{"select /*!99999 1, */ 2", DefaultServerVersion, []token.Type{token.Select, token.Comment, token.NumberLiteral}},
+		{"select /*!800001, */ 2", 80000, []token.Type{token.Select, token.NumberLiteral, token.Comma, token.NumberLiteral}},
+		{"select /*!80000 1 -- one\n, # two\n */ 2", DefaultServerVersion,
+			[]token.Type{token.Select, token.NumberLiteral, token.Comment, token.Comma, token.Comment, token.NumberLiteral}},
+		{"/*+ hint */ select", DefaultServerVersion, []token.Type{token.Comment, token.Select}},
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA} {
+		for _, tc := range testCases {
+			l := NewLexer(fa)
+			l.SetSkipComments(false)
+			l.SetServerVersion(tc.serverVersion)
+			tokens := l.Lex(tc.sql)
+			asst.Equal(tc.expected, getTokenTypes(tokens), "test Lex() failed. sql: %s", tc.sql)
+			for _, tk := range tokens {
+				asst.Equal(tk.Lexeme, tc.sql[tk.Span.Start.Offset:tk.Span.End.Offset], "test Lex() failed. sql: %s", tc.sql)
+			}
+		}
+	}
+
+	// the spans of the tokens in the executable comment are the positions in the whole input
+	tokens := testDFALexer.Lex("select\n/*!80000 col1 */ from t01")
+	asst.Equal(token.NewSpan(token.NewPosition(16, 16, 2, 10), token.NewPosition(20, 20, 2, 14)), tokens[1].GetSpan(), "test Lex() failed")
+	asst.Equal(token.NewSpan(token.NewPosition(24, 24, 2, 18), token.NewPosition(28, 28, 2, 22)), tokens[2].GetSpan(), "test Lex() failed")
+}
+
+func TestLexer_NumberLiteral(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql       string
+		tokenType token.Type
+		value     interface{}
+	}{
+		{"123", token.NumberLiteral, int64(123)},
+		{"0", token.NumberLiteral, int64(0)},
+		{"9223372036854775807", token.NumberLiteral, int64(9223372036854775807)},
+		{"9223372036854775808", token.NumberLiteral, new(big.Rat).SetInt64(1).Add(new(big.Rat).SetInt64(9223372036854775807), new(big.Rat).SetInt64(1))},
+		{"1.5", token.DecimalLiteral, big.NewRat(3, 2)},
+		{".5", token.DecimalLiteral, big.NewRat(1, 2)},
+		{"123.", token.DecimalLiteral, big.NewRat(123, 1)},
+		{"0.10", token.DecimalLiteral, big.NewRat(1, 10)},
+		{"1e10", token.FloatLiteral, 1e10},
+		{"1.5E-3", token.FloatLiteral, 1.5e-3},
+		{".5e+2", token.FloatLiteral, 50.0},
+		{"2.e2", token.FloatLiteral, 200.0},
+		{"0x1F", token.HexLiteral, []byte{0x1f}},
+		{"0xabc", token.HexLiteral, []byte{0x0a, 0xbc}},
+		{"X'1F'", token.HexLiteral, []byte{0x1f}},
+		{"x'0102'", token.HexLiteral, []byte{0x01, 0x02}},
+		{"x''", token.HexLiteral, []byte{}},
+		{"b'101'", token.BitLiteral, []byte{0x05}},
+		{"B'111111111'", token.BitLiteral, []byte{0x01, 0xff}},
+		{"0b10000000", token.BitLiteral, []byte{0x80}},
+		{"b''", token.BitLiteral, []byte{}},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range testCases {
+			tokens := l.Lex(tc.sql)
+			if asst.Equal(1, len(tokens), "test Lex() failed. sql: %s", tc.sql) {
+				asst.Equal(tc.tokenType, tokens[0].Type, "test Lex() failed. sql: %s", tc.sql)
+				asst.Equal(tc.value, tokens[0].GetValue(), "test Lex() failed. sql: %s", tc.sql)
+			}
+		}
+	}
+
+	invalidCases := []struct {
+		sql      string
+		expected []token.Type
+	}{
+		{"x'1F", []token.Type{token.Error}},
+		{"x'123'", []token.Type{token.Error}},
+		{"X'1g'", []token.Type{token.Error}},
+		{"b'102'", []token.Type{token.Error}},
+		{"select x'123' from t01", []token.Type{token.Select, token.Error, token.From, token.Identifier}},
+		{"1e", []token.Type{token.Identifier}},
+		{"1e+", []token.Type{token.Identifier, token.Plus}},
+		{"1.5.5", []token.Type{token.DecimalLiteral, token.DecimalLiteral}},
+	}
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range invalidCases {
+			asst.Equal(tc.expected, getTokenTypes(l.Lex(tc.sql)), "test Lex() failed. sql: %s", tc.sql)
+		}
+	}
+}
+
+func TestLexer_Unicode(t *testing.T) {
+	asst := assert.New(t)
+
+	// the runes in U+0080..U+FFFF could be used in the unquoted identifiers, any rune could be used in the quoted text
+	sql := "select 名字, café from 表1 where 名字 = '中文字符串😀' and `列 1` = \"é\""
+	expected := []token.Type{
+		token.Select, token.Identifier, token.Comma, token.Identifier, token.From, token.Identifier, token.Where,
+		token.Identifier, token.Equal, token.StringLiteral, token.And, token.QuotedIdentifier, token.Equal, token.StringLiteral,
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		tokens := l.Lex(sql)
+		asst.Equal(expected, getTokenTypes(tokens), "test Lex() failed")
+		if len(tokens) == len(expected) {
+			asst.Equal("名字", tokens[1].Lexeme, "test Lex() failed")
+			asst.Equal("中文字符串😀", tokens[9].GetValue(), "test Lex() failed")
+			asst.Equal("列 1", tokens[11].GetValue(), "test Lex() failed")
+			for _, tk := range tokens {
+				asst.Equal(tk.Lexeme, sql[tk.Span.Start.Offset:tk.Span.End.Offset], "test Lex() failed")
+			}
+		}
+
+		// the runes out of the basic multilingual plane could not be used in the unquoted identifiers
+		asst.Equal([]token.Type{token.Identifier, token.Error}, getTokenTypes(l.Lex("a😀")), "test Lex() failed")
+	}
+
+	// the control runes could be used in the quoted text and the comments, but they could not be the beginning of any token
+	controlCases := []struct {
+		sql      string
+		expected []token.Type
+	}{
+		{"select '\x01'", []token.Type{token.Select, token.StringLiteral}},
+		{"select \"\x00\x1f\x7f\", `\x02`", []token.Type{token.Select, token.StringLiteral, token.Comma, token.QuotedIdentifier}},
+		{"select 1 /* \x01 */ -- \x01\n# \x01", []token.Type{token.Select, token.NumberLiteral, token.Comment, token.Comment, token.Comment}},
+		{"select \x01\x1f a", []token.Type{token.Select, token.Error, token.Identifier}},
+	}
+	for _, l := range []*Lexer{testNFALexer, testDFALexer, testTableDFALexer} {
+		l := *l
+		l.SetSkipComments(false)
+		for _, tc := range controlCases {
+			asst.Equal(tc.expected, getTokenTypes(l.Lex(tc.sql)), "test Lex() failed. sql: %q", tc.sql)
+		}
+	}
+	asst.Equal("\x01", testDFALexer.Lex("select '\x01'")[1].GetValue(), "test Lex() failed")
+}
+
+func TestLexer_Placeholder(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql       string
+		tokenType token.Type
+		value     interface{}
+	}{
+		{"?", token.PositionalPlaceholder, nil},
+		{"$1", token.PositionalPlaceholder, int64(1)},
+		{"$12", token.PositionalPlaceholder, int64(12)},
+		{":name", token.NamedPlaceholder, "name"},
+		{":名字1", token.NamedPlaceholder, "名字1"},
+		{"@v", token.UserVariable, "v"},
+		{"@a.b$c", token.UserVariable, "a.b$c"},
+		{"@1", token.UserVariable, "1"},
+		{"@'my-var'", token.UserVariable, "my-var"},
+		{`@"it""s"`, token.UserVariable, `it"s`},
+		{"@`a``b`", token.UserVariable, "a`b"},
+		{"@@autocommit", token.SystemVariable, token.NewScopedVariable(token.DefaultScope, "autocommit")},
+		{"@@GLOBAL.max_connections", token.SystemVariable, token.NewScopedVariable(token.GlobalScope, "max_connections")},
+		{"@@session.sql_mode", token.SystemVariable, token.NewScopedVariable(token.SessionScope, "sql_mode")},
+		{"@@local.sql_mode", token.SystemVariable, token.NewScopedVariable(token.SessionScope, "sql_mode")},
+		{"@@persist.x", token.SystemVariable, token.NewScopedVariable(token.PersistScope, "x")},
+		{"@@persist_only.x", token.SystemVariable, token.NewScopedVariable(token.PersistOnlyScope, "x")},
+		{"@@validate_password.length", token.SystemVariable, token.NewScopedVariable(token.DefaultScope, "validate_password.length")},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range testCases {
+			tokens := l.Lex(tc.sql)
+			if asst.Equal(1, len(tokens), "test Lex() failed. sql: %s", tc.sql) {
+				asst.Equal(tc.tokenType, tokens[0].Type, "test Lex() failed. sql: %s", tc.sql)
+				asst.Equal(tc.value, tokens[0].GetValue(), "test Lex() failed. sql: %s", tc.sql)
+			}
+		}
+
+		sql := "select * from t where a = ? and b = :b or c = @@global.c"
+		expected := []token.Type{
+			token.Select, token.Multiply, token.From, token.Identifier, token.Where,
+			token.Identifier, token.Equal, token.PositionalPlaceholder, token.And,
+			token.Identifier, token.Equal, token.NamedPlaceholder, token.Or,
+			token.Identifier, token.Equal, token.SystemVariable,
+		}
+		asst.Equal(expected, getTokenTypes(l.Lex(sql)), "test Lex() failed")
+		asst.Equal([]token.Type{token.PositionalPlaceholder, token.PositionalPlaceholder}, getTokenTypes(l.Lex("??")), "test Lex() failed")
+		asst.Equal([]token.Type{token.Error, token.Identifier}, getTokenTypes(l.Lex("$a")), "test Lex() failed")
+	}
+}
+
+func TestLexer_Lossless(t *testing.T) {
+	asst := assert.New(t)
+
+	sqls := []string{
+		"",
+		" \n\t",
+		"-- only a comment",
+		"select 1",
+		"  select a, -- first\n\t b /* second */\n\n  from t01 # third\nwhere a = '中文'  ;\n\n",
+		"select /*!80000 col1, */ col2 from t01 /*!99999 where 1 */",
+		"select 1 /* unterminated",
+		"select a\r\n  from t01\r\n",
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA} {
+		for _, skipComments := range []bool{true, false} {
+			l := NewLexer(fa)
+			l.SetSkipComments(skipComments)
+			l.SetLossless(true)
+			for _, sql := range sqls {
+				tokens := l.Lex(sql)
+				asst.Equal(sql, token.GetFullText(tokens), "test Lex() failed. sql: %s", sql)
+				asst.Equal(token.End, tokens[len(tokens)-1].Type, "test Lex() failed. sql: %s", sql)
+				for _, tk := range tokens {
+					asst.False(tk.Type.IsTrivia(), "test Lex() failed. sql: %s", sql)
+					for _, trivia := range append(tk.GetLeadingTrivia(), tk.GetTrailingTrivia()...) {
+						asst.Equal(trivia.Lexeme, sql[trivia.Span.Start.Offset:trivia.Span.End.Offset], "test Lex() failed. sql: %s", sql)
+					}
+					fullSpan := tk.GetFullSpan()
+					asst.Equal(tk.GetFullLexeme(), sql[fullSpan.Start.Offset:fullSpan.End.Offset], "test Lex() failed. sql: %s", sql)
+				}
+			}
+		}
+	}
+
+	// the trivia until the end of the line are the trailing trivia, the others are the leading trivia of the next token
+	l := NewLexer(testDFA)
+	l.SetLossless(true)
+	tokens := l.Lex("  select a, -- first\n\t b\n\n  from t01 # third\n")
+	asst.Equal([]token.Type{token.Select, token.Identifier, token.Comma, token.Identifier, token.From, token.Identifier, token.End},
+		getTokenTypes(tokens), "test Lex() failed")
+	if len(tokens) == 7 {
+		asst.Equal([]string{"  "}, getLexemes(tokens[0].GetLeadingTrivia()), "test Lex() failed")
+		asst.Equal([]string{" "}, getLexemes(tokens[0].GetTrailingTrivia()), "test Lex() failed")
+		asst.Equal([]string{" ", "-- first", "\n"}, getLexemes(tokens[2].GetTrailingTrivia()), "test Lex() failed")
+		asst.Equal([]string{"\t "}, getLexemes(tokens[3].GetLeadingTrivia()), "test Lex() failed")
+		asst.Equal([]string{"\n"}, getLexemes(tokens[3].GetTrailingTrivia()), "test Lex() failed")
+		asst.Equal([]string{"\n  "}, getLexemes(tokens[4].GetLeadingTrivia()), "test Lex() failed")
+		asst.Equal([]string{" ", "# third", "\n"}, getLexemes(tokens[5].GetTrailingTrivia()), "test Lex() failed")
+		asst.Empty(tokens[6].GetLeadingTrivia(), "test Lex() failed")
+	}
+
+	// the prefix and the suffix of the executed comment are kept as the trivia
+	tokens = l.Lex("select /*!80000 1 */")
+	asst.Equal([]token.Type{token.Select, token.NumberLiteral, token.End}, getTokenTypes(tokens), "test Lex() failed")
+	if len(tokens) == 3 {
+		asst.Equal([]string{" ", "/*!80000", " "}, getLexemes(tokens[0].GetTrailingTrivia()), "test Lex() failed")
+		asst.Equal([]string{" ", "*/"}, getLexemes(tokens[1].GetTrailingTrivia()), "test Lex() failed")
+	}
+
+	// the tokens are not changed if the lexer is not in lossless mode
+	tokens = testDFALexer.Lex("select a -- comment\n")
+	asst.Equal([]token.Type{token.Select, token.Identifier}, getTokenTypes(tokens), "test Lex() failed")
+	for _, tk := range tokens {
+		asst.Nil(tk.GetLeadingTrivia(), "test Lex() failed")
+		asst.Nil(tk.GetTrailingTrivia(), "test Lex() failed")
+	}
+}
+
+func TestLexer_Diagnostics(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql      string
+		expected []token.Type
+		codes    []DiagnosticCode
+		texts    []string
+	}{
+		{
+			"select a from t01 where b = 'abc'",
+			[]token.Type{token.Select, token.Identifier, token.From, token.Identifier, token.Where, token.Identifier, token.Equal, token.StringLiteral},
+			nil,
+			nil,
+		},
+		{
+			// the unterminated string ends at the end of the line, and the next line is scanned as usual
+			"select 'abc\nfrom t01",
+			[]token.Type{token.Select, token.Error, token.From, token.Identifier},
+			[]DiagnosticCode{UnterminatedString},
+			[]string{"'abc"},
+		},
+		{
+			"select `a\nb from t01",
+			[]token.Type{token.Select, token.Error, token.Identifier, token.From, token.Identifier},
+			[]DiagnosticCode{UnterminatedQuotedIdentifier},
+			[]string{"`a"},
+		},
+		{
+			"select 1 /* abc\nfrom t01",
+			[]token.Type{token.Select, token.NumberLiteral, token.Error},
+			[]DiagnosticCode{UnterminatedComment},
+			[]string{"/* abc\nfrom t01"},
+		},
+		{
+			// the adjacent unknown characters are reported once
+			"select a { b \\\\ c, {",
+			[]token.Type{token.Select, token.Identifier, token.Error, token.Identifier, token.Error, token.Identifier, token.Comma, token.Error},
+			[]DiagnosticCode{UnknownCharacter, UnknownCharacter, UnknownCharacter},
+			[]string{"{", "\\\\", "{"},
+		},
+		{
+			// all the problems are reported at once
+			"select { from t01 where a = \"abc",
+			[]token.Type{token.Select, token.Error, token.From, token.Identifier, token.Where, token.Identifier, token.Equal, token.Error},
+			[]DiagnosticCode{UnknownCharacter, UnterminatedString},
+			[]string{"{", "\"abc"},
+		},
+		{
+			// the malformed quoted literals are not split into an identifier and a string
+			"select x'123', B'102', x'12', b'1' from t01",
+			[]token.Type{token.Select, token.Error, token.Comma, token.Error, token.Comma, token.HexLiteral, token.Comma, token.BitLiteral,
+				token.From, token.Identifier},
+			[]DiagnosticCode{InvalidHexLiteral, InvalidBitLiteral},
+			[]string{"x'123'", "B'102'"},
+		},
+		{
+			// the diagnostics in the executable comment are also reported
+			"select /*!80000 { */ 1",
+			[]token.Type{token.Select, token.Error, token.NumberLiteral},
+			[]DiagnosticCode{UnknownCharacter},
+			[]string{"{"},
+		},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range testCases {
+			tokens, diagnostics := l.LexWithDiagnostics(tc.sql)
+			asst.Equal(tc.expected, getTokenTypes(tokens), "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+
+			var (
+				codes []DiagnosticCode
+				texts []string
+			)
+			for _, d := range diagnostics {
+				codes = append(codes, d.Code)
+				texts = append(texts, d.Text)
+				asst.Equal(d.Text, tc.sql[d.Span.Start.Offset:d.Span.End.Offset], "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+				asst.NotEmpty(d.Message, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+			}
+			asst.Equal(tc.codes, codes, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+			asst.Equal(tc.texts, texts, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+		}
+	}
+
+	// the double-quoted text is a quoted identifier if ANSI_QUOTES is enabled
+	cs := NewCharacterSetWithDefault()
+	ansiNFA, err := NewNFAWithRules(cs, GetDefaultRulesWithANSIQuotes(cs, true))
+	asst.Nil(err, "test LexWithDiagnostics() failed")
+	ansiCases := []struct {
+		sql     string
+		code    DiagnosticCode
+		message string
+	}{
+		{"select \"abc", UnterminatedQuotedIdentifier, "the closing quote \" is missing"},
+		{"select \"a'b", UnterminatedQuotedIdentifier, "the closing quote \" is missing"},
+		{"select \"a\\", UnterminatedQuotedIdentifier, "the closing quote \" is missing"},
+		{"select 'a\"b", UnterminatedString, "the closing quote ' is missing"},
+		{"select 'a\\", UnterminatedString, "the closing quote ' is missing"},
+		{"select `a\"b", UnterminatedQuotedIdentifier, "the closing quote ` is missing"},
+		{"select x'1f", UnterminatedString, "the closing quote ' is missing"},
+	}
+	for _, l := range []*Lexer{NewLexer(ansiNFA), NewLexer(NewDFAWithNFA(ansiNFA))} {
+		for _, tc := range ansiCases {
+			_, diagnostics := l.LexWithDiagnostics(tc.sql)
+			if asst.Equal(1, len(diagnostics), "test LexWithDiagnostics() failed. sql: %s", tc.sql) {
+				asst.Equal(tc.code, diagnostics[0].Code, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+				asst.Equal(tc.message, diagnostics[0].Message, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+			}
+		}
+	}
+	// without ANSI_QUOTES, the double-quoted text is a string, the opening quote is the first quote of the token
+	for _, tc := range []struct {
+		sql  string
+		code DiagnosticCode
+	}{
+		{"select \"abc", UnterminatedString},
+		{"select \"a'b", UnterminatedString},
+		{"select \"a`b", UnterminatedString},
+		{"select `a'b", UnterminatedQuotedIdentifier},
+	} {
+		_, diagnostics := testDFALexer.LexWithDiagnostics(tc.sql)
+		if asst.Equal(1, len(diagnostics), "test LexWithDiagnostics() failed. sql: %s", tc.sql) {
+			asst.Equal(tc.code, diagnostics[0].Code, "test LexWithDiagnostics() failed. sql: %s", tc.sql)
+		}
+	}
+
+	_, diagnostics := testDFALexer.LexWithDiagnostics("select\n  'abc")
+	asst.Equal(1, len(diagnostics), "test LexWithDiagnostics() failed")
+	if len(diagnostics) == 1 {
+		asst.Equal(token.NewPosition(9, 9, 2, 3), diagnostics[0].Span.Start, "test LexWithDiagnostics() failed")
+		asst.Equal("the closing quote ' is missing", diagnostics[0].Message, "test LexWithDiagnostics() failed")
+	}
+}
+
+func TestLexer_Operator(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		sql      string
+		expected []token.Type
+	}{
+		{"a<=>b", []token.Type{token.Identifier, token.NullSafeEqual, token.Identifier}},
+		{"a<=b", []token.Type{token.Identifier, token.LE, token.Identifier}},
+		{"a<<b", []token.Type{token.Identifier, token.LeftShift, token.Identifier}},
+		{"a>>b", []token.Type{token.Identifier, token.RightShift, token.Identifier}},
+		{"a<<=b", []token.Type{token.Identifier, token.LeftShift, token.Equal, token.Identifier}},
+		{"a||b|c", []token.Type{token.Identifier, token.LogicalOr, token.Identifier, token.BitOr, token.Identifier}},
+		{"a&&b&c", []token.Type{token.Identifier, token.LogicalAnd, token.Identifier, token.BitAnd, token.Identifier}},
+		{"a|||b", []token.Type{token.Identifier, token.LogicalOr, token.BitOr, token.Identifier}},
+		{"!a != ~b ^ c", []token.Type{token.LogicalNot, token.Identifier, token.NotEqual1, token.BitNot, token.Identifier, token.BitXor, token.Identifier}},
+		{"@v:=1", []token.Type{token.UserVariable, token.Assign, token.NumberLiteral}},
+		{"a:=:b", []token.Type{token.Identifier, token.Assign, token.NamedPlaceholder}},
+		{"a->'$.b'", []token.Type{token.Identifier, token.JSONExtract, token.StringLiteral}},
+		{"a->>'$.b'", []token.Type{token.Identifier, token.JSONUnquoteExtract, token.StringLiteral}},
+		{"a->>>b", []token.Type{token.Identifier, token.JSONUnquoteExtract, token.GT, token.Identifier}},
+		{"a-->b", []token.Type{token.Identifier, token.Minus, token.JSONExtract, token.Identifier}},
+		{"t01.a.`b`", []token.Type{token.Identifier, token.Dot, token.Identifier, token.Dot, token.QuotedIdentifier}},
+		{"a.5", []token.Type{token.Identifier, token.DecimalLiteral}},
+		{"'root'@'localhost'", []token.Type{token.StringLiteral, token.UserVariable}},
+		{"'root' @ 'localhost'", []token.Type{token.StringLiteral, token.At, token.StringLiteral}},
+		{"5 div 2 mod 3 % 4", []token.Type{token.NumberLiteral, token.DivKeyword, token.NumberLiteral, token.ModKeyword, token.NumberLiteral, token.Mod, token.NumberLiteral}},
+	}
+
+	for _, l := range []*Lexer{testNFALexer, testDFALexer} {
+		for _, tc := range testCases {
+			tokens, diagnostics := l.LexWithDiagnostics(tc.sql)
+			asst.Equal(tc.expected, getTokenTypes(tokens), "test Operator failed. sql: %s", tc.sql)
+			asst.Empty(diagnostics, "test Operator failed. sql: %s", tc.sql)
+		}
+	}
+
+	for _, tokenType := range []token.Type{token.NullSafeEqual, token.LogicalNot, token.BitNot, token.JSONUnquoteExtract, token.DivKeyword, token.ModKeyword} {
+		asst.True(tokenType.IsOperator(), "test Operator failed. token type: %s", tokenType.String())
+	}
+	for _, tokenType := range []token.Type{token.Dot, token.At, token.Comma, token.Select} {
+		asst.False(tokenType.IsOperator(), "test Operator failed. token type: %s", tokenType.String())
+	}
+}
+
+func getLexemes(tokens []*token.Token) []string {
+	lexemes := make([]string, len(tokens))
+	for i, tk := range tokens {
+		lexemes[i] = tk.Lexeme
+	}
+
+	return lexemes
+}
@@ -0,0 +1,170 @@
+package lexer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// SQLModeName is the name of the mode which scans the sql with the finite automata of the lexer
+	SQLModeName = "sql"
+	// HintModeName is the name of the mode which scans the content of the optimizer hint like /*+ BKA(t1) */
+	HintModeName = "hint"
+
+	hintPrefix = "/*+"
+)
+
+var (
+	hintMode     *Mode
+	hintModeOnce sync.Once
+)
+
+// Mode is a lexing context which has its own finite automata, e.g. the content of the optimizer hint is scanned in the hint mode,
+// the scanner keeps a stack of the modes, the rules of the mode on the top push the other modes,
+// the DELIMITER command of the mysql client is not a mode, it changes the delimiter of the script rather than the tokens,
+// so it is handled by SplitScript
+type Mode struct {
+	Name  string
+	FA    dependency.Lexer
+	Rules []*ModeRule
+}
+
+// NewMode returns a new *Mode
+func NewMode(name string, fa dependency.Lexer, rules ...*ModeRule) *Mode {
+	return &Mode{
+		Name:  name,
+		FA:    fa,
+		Rules: rules,
+	}
+}
+
+// NewSQLMode returns the sql mode of the given finite automata,
+// the content of the executable comment which should be executed is scanned in the sql mode itself
+func NewSQLMode(fa dependency.Lexer) *Mode {
+	m := NewMode(SQLModeName, fa)
+	m.AddRule(newExecutableCommentRule(m))
+
+	return m
+}
+
+// GetHintMode returns the mode of the optimizer hint, the finite automata is built only once
+func GetHintMode() *Mode {
+	hintModeOnce.Do(func() {
+		cs := NewCharacterSetWithDefault()
+		dfa, err := NewDFAWithRules(cs, GetHintRules(cs))
+		if err != nil {
+			// the patterns of the hint rules are always valid
+			panic(err)
+		}
+		dfa.Minimize()
+		hintMode = NewMode(HintModeName, dfa)
+	})
+
+	return hintMode
+}
+
+// AddRule adds a rule which pushes a mode, the rules are matched in the order they are added
+func (m *Mode) AddRule(rule *ModeRule) {
+	m.Rules = append(m.Rules, rule)
+}
+
+// match returns the first rule which splits the token, the prefix and the content of the token are also returned,
+// it returns false if the token does not push any mode
+func (m *Mode) match(tokenType token.Type, lexeme string, serverVersion int) (*ModeRule, string, string, bool) {
+	for _, rule := range m.Rules {
+		if rule.TokenType != tokenType {
+			continue
+		}
+		prefix, content, ok := rule.split(lexeme, serverVersion)
+		if ok {
+			return rule, prefix, content, true
+		}
+	}
+
+	return nil, constant.EmptyString, constant.EmptyString, false
+}
+
+// ModeRule pushes a mode when a token of the token type is scanned and its lexeme could be split by the rule,
+// the prefix and the suffix of the lexeme are returned as the tokens of BeginType and EndType,
+// the content between them is scanned in the pushed mode, and the mode is popped after the content is fully scanned,
+// if BeginType or EndType is a trivia, the token is only returned in lossless mode, e.g. the prefix of the executable comment
+type ModeRule struct {
+	TokenType token.Type
+	Mode      *Mode
+	BeginType token.Type
+	EndType   token.Type
+	split     func(lexeme string, serverVersion int) (prefix string, content string, ok bool)
+}
+
+// NewModeRule returns a new *ModeRule which pushes the mode for the content of the token which begins with the prefix and ends with the suffix
+func NewModeRule(tokenType token.Type, prefix, suffix string, mode *Mode, beginType, endType token.Type) *ModeRule {
+	return &ModeRule{
+		TokenType: tokenType,
+		Mode:      mode,
+		BeginType: beginType,
+		EndType:   endType,
+		split: func(lexeme string, serverVersion int) (string, string, bool) {
+			if len(lexeme) < len(prefix)+len(suffix) || !strings.HasPrefix(lexeme, prefix) || !strings.HasSuffix(lexeme, suffix) {
+				return constant.EmptyString, constant.EmptyString, false
+			}
+
+			return lexeme[:len(prefix)], lexeme[len(prefix) : len(lexeme)-len(suffix)], true
+		},
+	}
+}
+
+// NewHintRule returns the rule which pushes the hint mode for the optimizer hint like /*+ BKA(t1) */,
+// without this rule, the optimizer hint is a normal comment
+func NewHintRule() *ModeRule {
+	return NewModeRule(token.Comment, hintPrefix, blockCommentSuffix, GetHintMode(), token.HintBegin, token.HintEnd)
+}
+
+// newExecutableCommentRule returns the rule which pushes the given mode for the executable comment like /*!80000 ... */,
+// the executable comment is executed only if its version is not larger than the server version
+func newExecutableCommentRule(mode *Mode) *ModeRule {
+	return &ModeRule{
+		TokenType: token.Comment,
+		Mode:      mode,
+		BeginType: token.Comment,
+		EndType:   token.Comment,
+		split: func(lexeme string, serverVersion int) (string, string, bool) {
+			prefix, version, content, ok := parseExecutableComment(lexeme)
+			if !ok || version > serverVersion {
+				// this is a normal comment, or the server version is too low to execute it
+				return constant.EmptyString, constant.EmptyString, false
+			}
+
+			return prefix, content, true
+		},
+	}
+}
+
+// GetHintRules returns the rules of the tokens in the optimizer hint,
+// the hint names like BKA and the query block names like @qb1 are scanned as the identifiers and the at signs
+func GetHintRules(cs *CharacterSet) []*Rule {
+	alphabets := getRangesPattern(cs.GetAlphabetRanges())
+	digits := getRangesPattern(cs.GetDigitRanges())
+	alphabetsOrDigits := getRangesPattern(normalizeRanges(append(cs.GetAlphabetRanges(), cs.GetDigitRanges()...)))
+
+	return []*Rule{
+		// the value of SET_VAR could be like 16M, so the identifier may start with digits as well
+		NewRule(token.Identifier, fmt.Sprintf("%s*%s%s*", digits, alphabets, alphabetsOrDigits), DefaultPriority),
+		NewRule(token.QuotedIdentifier, backtickQuotedIdentifierPattern, DefaultPriority),
+		NewRule(token.StringLiteral, singleQuotedStringPattern, DefaultPriority),
+		NewRule(token.StringLiteral, doubleQuotedStringPattern, DefaultPriority),
+		NewRule(token.NumberLiteral, integerPattern, DefaultPriority),
+		NewRule(token.DecimalLiteral, decimalPattern, DefaultPriority),
+		NewRule(token.LeftParenthesis, QuoteMeta(string(LeftParenthesisRune)), DefaultPriority),
+		NewRule(token.RightParenthesis, QuoteMeta(string(RightParenthesisRune)), DefaultPriority),
+		NewRule(token.Comma, QuoteMeta(string(CommaRune)), DefaultPriority),
+		NewRule(token.Dot, QuoteMeta(string(DotRune)), DefaultPriority),
+		NewRule(token.At, QuoteMeta(string(AtRune)), DefaultPriority),
+		NewRule(token.Equal, QuoteMeta(string(EqualRune)), DefaultPriority),
+		NewRule(token.WhiteSpace, fmt.Sprintf("%s+", getClassPattern(WhiteSpaceRunes)), DefaultPriority),
+	}
+}
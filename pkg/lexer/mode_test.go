@@ -0,0 +1,143 @@
+package lexer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+const testJSONPathModeName = "jsonPath"
+
+func TestMode_All(t *testing.T) {
+	TestMode_Hint(t)
+	TestMode_Lossless(t)
+	TestMode_Stack(t)
+	TestMode_Diagnostics(t)
+}
+
+// newTestJSONPathRule returns the rule which pushes a mode for the json path like '$.a.*'
+func newTestJSONPathRule() (*ModeRule, error) {
+	cs := NewCharacterSetWithDefault()
+	dfa, err := NewDFAWithRules(cs, []*Rule{
+		NewRule(token.Identifier, fmt.Sprintf("%s+", getRangesPattern(cs.GetAlphabetRanges())), DefaultPriority),
+		NewRule(token.Dot, QuoteMeta(string(DotRune)), DefaultPriority),
+		NewRule(token.Multiply, QuoteMeta(string(MultiplyRune)), DefaultPriority),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewModeRule(token.StringLiteral, "'$", "'", NewMode(testJSONPathModeName, dfa), token.SingleQuote, token.SingleQuote), nil
+}
+
+func TestMode_Hint(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select /*+ BKA(t1) MAX_EXECUTION_TIME(1000) SET_VAR(sort_buffer_size = 16M) QB_NAME(qb1) */ a from t1 /*+ x */"
+	expected := []token.Type{
+		token.Select, token.HintBegin,
+		token.Identifier, token.LeftParenthesis, token.Identifier, token.RightParenthesis,
+		token.Identifier, token.LeftParenthesis, token.NumberLiteral, token.RightParenthesis,
+		token.Identifier, token.LeftParenthesis, token.Identifier, token.Equal, token.Identifier, token.RightParenthesis,
+		token.Identifier, token.LeftParenthesis, token.Identifier, token.RightParenthesis,
+		token.HintEnd, token.Identifier, token.From, token.Identifier, token.HintBegin, token.Identifier, token.HintEnd,
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA, testTableDFA} {
+		l := NewLexer(fa)
+		l.AddModeRule(NewHintRule())
+		tokens, diagnostics := l.LexWithDiagnostics(sql)
+		asst.Equal(expected, getTokenTypes(tokens), "test Hint failed. sql: %s", sql)
+		asst.Empty(diagnostics, "test Hint failed. sql: %s", sql)
+		asst.Equal(tokens, l.Lex(sql), "test Hint failed. sql: %s", sql)
+		for _, tk := range tokens {
+			asst.Equal(tk.Lexeme, sql[tk.Span.Start.Offset:tk.Span.End.Offset], "test Hint failed. sql: %s", sql)
+		}
+		asst.Equal("/*+", tokens[1].Lexeme, "test Hint failed. sql: %s", sql)
+		asst.Equal("*/", tokens[20].Lexeme, "test Hint failed. sql: %s", sql)
+	}
+
+	// without the rule, the hint is a normal comment
+	asst.Equal([]token.Type{token.Select, token.Identifier}, getTokenTypes(testDFALexer.Lex("select /*+ BKA(t1) */ a")), "test Hint failed")
+}
+
+func TestMode_Lossless(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select /*+ BKA(t1) */ /*!80000 a, */ b -- comment\nfrom t1 /*+*/"
+	l := NewLexer(testDFA)
+	l.AddModeRule(NewHintRule())
+	l.SetLossless(true)
+	tokens := l.Lex(sql)
+	asst.Equal(sql, token.GetFullText(tokens), "test Lossless failed. sql: %s", sql)
+	asst.Equal([]token.Type{
+		token.Select, token.HintBegin, token.Identifier, token.LeftParenthesis, token.Identifier, token.RightParenthesis, token.HintEnd,
+		token.Identifier, token.Comma, token.Identifier, token.From, token.Identifier, token.HintBegin, token.HintEnd, token.End,
+	}, getTokenTypes(tokens), "test Lossless failed. sql: %s", sql)
+}
+
+func TestMode_Stack(t *testing.T) {
+	asst := assert.New(t)
+
+	rule, err := newTestJSONPathRule()
+	asst.Nil(err, "test Stack failed")
+
+	// the json path in the executable comment is scanned in the json path mode which is pushed by the sql mode of the executable comment
+	sql := "select c->'$.a.*', /*!80000 d->>'$.b', */ 'e' from t1"
+	expected := []token.Type{
+		token.Select, token.Identifier, token.JSONExtract, token.SingleQuote, token.Dot, token.Identifier, token.Dot, token.Multiply, token.SingleQuote, token.Comma,
+		token.Identifier, token.JSONUnquoteExtract, token.SingleQuote, token.Dot, token.Identifier, token.SingleQuote, token.Comma,
+		token.StringLiteral, token.From, token.Identifier,
+	}
+	for _, fa := range []dependency.Lexer{testNFA, testDFA, testTableDFA} {
+		l := NewLexer(fa)
+		l.AddModeRule(rule)
+		tokens, diagnostics := l.LexWithDiagnostics(sql)
+		asst.Equal(expected, getTokenTypes(tokens), "test Stack failed. sql: %s", sql)
+		asst.Empty(diagnostics, "test Stack failed. sql: %s", sql)
+		asst.Equal(tokens, l.Lex(sql), "test Stack failed. sql: %s", sql)
+	}
+
+	s := NewScanner(testDFA, strings.NewReader(sql))
+	s.AddModeRule(rule)
+	asst.Equal([]string{SQLModeName}, s.GetModes(), "test GetModes() failed")
+	modes := make(map[string][]string)
+	for {
+		tk, err := s.Next()
+		if err != nil {
+			break
+		}
+		if tk.Type == token.Dot {
+			modes[tk.Span.String()] = s.GetModes()
+		}
+	}
+	asst.Equal(map[string][]string{
+		"1:13-1:14": {SQLModeName, testJSONPathModeName},
+		"1:15-1:16": {SQLModeName, testJSONPathModeName},
+		"1:35-1:36": {SQLModeName, SQLModeName, testJSONPathModeName},
+	}, modes, "test GetModes() failed")
+	asst.Equal([]string{SQLModeName}, s.GetModes(), "test GetModes() failed")
+}
+
+func TestMode_Diagnostics(t *testing.T) {
+	asst := assert.New(t)
+
+	// # is not a valid rune in the hint mode, and the unterminated hint is a normal error token
+	sql := "select /*+ BKA(t1 #) */ a from t1 /*+ x"
+	l := NewLexer(testDFA)
+	l.AddModeRule(NewHintRule())
+	tokens, diagnostics := l.LexWithDiagnostics(sql)
+	asst.Equal([]token.Type{
+		token.Select, token.HintBegin, token.Identifier, token.LeftParenthesis, token.Identifier, token.Error, token.RightParenthesis, token.HintEnd,
+		token.Identifier, token.From, token.Identifier, token.Error,
+	}, getTokenTypes(tokens), "test Diagnostics failed. sql: %s", sql)
+	asst.Equal(tokens, l.Lex(sql), "test Diagnostics failed. sql: %s", sql)
+	if asst.Len(diagnostics, 2, "test Diagnostics failed. sql: %s", sql) {
+		asst.Equal(UnknownCharacter, diagnostics[0].Code, "test Diagnostics failed. sql: %s", sql)
+		asst.Equal(UnterminatedComment, diagnostics[1].Code, "test Diagnostics failed. sql: %s", sql)
+	}
+}
@@ -1,7 +1,11 @@
 package lexer
 
 import (
+	"sync"
+
+	"github.com/pingcap/errors"
 	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
@@ -14,32 +18,59 @@ const (
 	AndString    = "and"
 	OrString     = "or"
 	// comparison operator
-	GEString        = ">="
-	LEString        = "<="
-	NotEqual1String = "!="
-	NotEqual2String = "<>"
+	GEString            = ">="
+	LEString            = "<="
+	NotEqual1String     = "!="
+	NotEqual2String     = "<>"
+	NullSafeEqualString = "<=>"
+	// logical operator
+	LogicalOrString  = "||"
+	LogicalAndString = "&&"
+	// bit operator
+	LeftShiftString  = "<<"
+	RightShiftString = ">>"
+	// assignment operator
+	AssignString = ":="
+	// json operator
+	JSONExtractString        = "->"
+	JSONUnquoteExtractString = "->>"
 )
 
 var (
-	MultiRuneMap = map[token.Type]string{
-		// keyword
-		token.Select: SelectString,
-		token.From:   FromString,
-		token.As:     AsString,
-		token.Where:  WhereString,
-		token.And:    AndString,
-		token.Or:     OrString,
+	// OperatorMap contains the multi rune operators
+	OperatorMap = map[token.Type]string{
 		// comparison operator
-		token.GE:        GEString,
-		token.LE:        LEString,
-		token.NotEqual1: NotEqual1String,
-		token.NotEqual2: NotEqual2String,
+		token.GE:            GEString,
+		token.LE:            LEString,
+		token.NotEqual1:     NotEqual1String,
+		token.NotEqual2:     NotEqual2String,
+		token.NullSafeEqual: NullSafeEqualString,
+		// logical operator
+		token.LogicalOr:  LogicalOrString,
+		token.LogicalAnd: LogicalAndString,
+		// bit operator
+		token.LeftShift:  LeftShiftString,
+		token.RightShift: RightShiftString,
+		// assignment operator
+		token.Assign: AssignString,
+		// json operator
+		token.JSONExtract:        JSONExtractString,
+		token.JSONUnquoteExtract: JSONUnquoteExtractString,
 	}
+	// MultiRuneMap contains the keywords and the multi rune operators
+	MultiRuneMap  = mergeMultiRuneMaps(KeywordMap, OperatorMap)
 	SingleRuneMap = map[token.Type]rune{
 		// comparison operator
 		token.GT:    GTRune,
 		token.LT:    LTRune,
 		token.Equal: EqualRune,
+		// logical operator
+		token.LogicalNot: ExclamationRune,
+		// bit operator
+		token.BitOr:  PipeRune,
+		token.BitAnd: AmpersandRune,
+		token.BitXor: CaretRune,
+		token.BitNot: TildeRune,
 		// arithmetic operator
 		token.Plus:     PlusRune,
 		token.Minus:    MinusRune,
@@ -52,6 +83,8 @@ var (
 		// symbol
 		token.Comma:     CommaRune,
 		token.Semicolon: SemicolonRune,
+		token.Dot:       DotRune,
+		token.At:        AtRune,
 	}
 )
 
@@ -59,16 +92,27 @@ type NFA struct {
 	CharacterSet *CharacterSet
 	Index        int
 	InitState    *State
+	Rules        []*Rule
+	// closures memoizes the epsilon closure of each state, it maps *State to []*State,
+	// it is reset when a rule is added as the epsilon moves may change
+	closures *sync.Map
 }
 
-// NewNFA returns a new *NFA
+// NewNFA returns a new *NFA which recognizes the built-in tokens
 func NewNFA(cs *CharacterSet) *NFA {
-	nfa := &NFA{
-		CharacterSet: cs,
-		Index:        -1,
-	}
+	return NewNFAWithANSIQuotes(cs, false)
+}
 
-	nfa.init()
+// NewNFAWithANSIQuotes returns a new *NFA which recognizes the built-in tokens,
+// if ansiQuotes is true, the double-quoted text is recognized as a quoted identifier instead of a string literal
+func NewNFAWithANSIQuotes(cs *CharacterSet, ansiQuotes bool) *NFA {
+	nfa := newNFA(cs)
+
+	err := nfa.AddRules(GetDefaultRulesWithANSIQuotes(cs, ansiQuotes))
+	if err != nil {
+		// the patterns of the default rules are always valid
+		panic(err)
+	}
 
 	return nfa
 }
@@ -80,164 +124,170 @@ func NewNFAWithDefault() *NFA {
 	return NewNFA(cs)
 }
 
-// init initialize the NFA
-func (nfa *NFA) init() {
+// NewNFAWithRules returns a new *NFA which is generated from the given rules
+func NewNFAWithRules(cs *CharacterSet, rules []*Rule) (*NFA, error) {
+	nfa := newNFA(cs)
+
+	err := nfa.AddRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return nfa, nil
+}
+
+// newNFA returns a new *NFA which only has the init state
+func newNFA(cs *CharacterSet) *NFA {
+	nfa := &NFA{
+		CharacterSet: cs,
+		Index:        -1,
+		closures:     &sync.Map{},
+	}
+
 	nfa.InitState = nfa.getNewState()
 
-	nfa.initMultiRune()
-	nfa.initSingleRune()
-	nfa.initIdentifier()
-	nfa.initStringLiteral()
-	nfa.initNumberLiteral()
+	return nfa
 }
 
-// initMultiRune initialize the states that can recognize tokens which have multi runes
-func (nfa *NFA) initMultiRune() {
-	for tokenType, tokenString := range MultiRuneMap {
-		start := nfa.getNewState()
-		// temporary state
-		tempState := start
-		nfa.InitState.AddNext(token.EpsilonRune, start)
-
-		for _, c := range tokenString {
-			s := nfa.getNewState()
-			tempState.AddNext(c, s)
-			tempState = s
+// AddRules adds the states that can recognize the tokens of the given rules
+func (nfa *NFA) AddRules(rules []*Rule) error {
+	for _, rule := range rules {
+		err := nfa.AddRule(rule)
+		if err != nil {
+			return err
 		}
-
-		final := nfa.getNewFinalState(tokenType)
-		tempState.AddNext(token.EpsilonRune, final)
 	}
-}
 
-// initIdentifier initialize the states that can recognize the identifier
-func (nfa *NFA) initIdentifier() {
-	start := nfa.getNewState()
-	nfa.InitState.AddNext(token.EpsilonRune, start)
+	return nil
+}
 
-	for _, c := range nfa.CharacterSet.GetDigits() {
-		start.AddNext(c, start)
+// AddRule compiles the pattern of the rule with Thompson's construction,
+// and adds the states that can recognize the token of the rule
+func (nfa *NFA) AddRule(rule *Rule) error {
+	node, err := parseRegexp(rule.Pattern, nfa.CharacterSet.GetRanges())
+	if err != nil {
+		return errors.Trace(err)
 	}
 
-	s := nfa.getNewState()
-	for _, c := range nfa.CharacterSet.GetAlphabets() {
-		start.AddNext(c, s)
-	}
+	start, end := nfa.compileRegexp(node)
+	nfa.InitState.AddNext(token.EpsilonRune, start)
 
-	for _, c := range nfa.CharacterSet.GetAlphabets() {
-		s.AddNext(c, s)
-	}
-	for _, c := range nfa.CharacterSet.GetDigits() {
-		s.AddNext(c, s)
-	}
+	final := nfa.getNewFinalState(rule.TokenType)
+	final.Priority = rule.Priority
+	end.AddNext(token.EpsilonRune, final)
+
+	nfa.Rules = append(nfa.Rules, rule)
+	nfa.closures = &sync.Map{}
 
-	final := nfa.getNewFinalState(token.Identifier)
-	s.AddNext(token.EpsilonRune, final)
+	return nil
 }
 
-// initSingleRune initialize the states that can recognize tokens which have single rune
-func (nfa *NFA) initSingleRune() {
-	for tokenType, c := range SingleRuneMap {
-		start := nfa.getNewState()
-		nfa.InitState.AddNext(token.EpsilonRune, start)
+// Print prints all the states
+func (nfa *NFA) Print() {
+	nfa.InitState.Print()
+}
 
-		s := nfa.getNewState()
-		start.AddNext(c, s)
+// Match matches the given runes and returns proper token,
+// it simulates the NFA with the set of all the states that the runes could reach,
+// so the time is linear in the length of the runes
+func (nfa *NFA) Match(runes []rune) *token.Token {
+	states := nfa.getClosure(nfa.InitState)
+	for _, c := range runes {
+		states = nfa.step(states, c)
+		if len(states) == constant.ZeroInt {
+			return token.NewToken(token.Error, string(runes))
+		}
+	}
 
-		final := nfa.getNewFinalState(tokenType)
-		s.AddNext(token.EpsilonRune, final)
+	final := getFinalState(states)
+	if final == nil {
+		return token.NewToken(token.Error, string(runes))
 	}
-}
 
-// initStringLiteral initialize the states that can recognize string literal token
-func (nfa *NFA) initStringLiteral() {
-	start := nfa.getNewState()
-	nfa.InitState.AddNext(token.EpsilonRune, start)
+	return token.NewToken(final.TokenType, string(runes))
+}
 
-	openQuote := nfa.getNewState()
-	start.AddNext(singleQuote, openQuote)
+// step returns the epsilon closure of the next states of the given states and rune
+func (nfa *NFA) step(states []*State, c rune) []*State {
+	var next []*State
 
-	for _, c := range nfa.CharacterSet.GetAlphabets() {
-		openQuote.AddNext(c, openQuote)
+	for _, s := range states {
+		next = append(next, s.GetNext(c)...)
 	}
-	for _, c := range nfa.CharacterSet.GetDigits() {
-		openQuote.AddNext(c, openQuote)
+	if len(next) == constant.ZeroInt {
+		return nil
 	}
 
-	closeQuote := nfa.getNewState()
-	openQuote.AddNext(singleQuote, closeQuote)
-
-	final := nfa.getNewFinalState(token.StringLiteral)
-	closeQuote.AddNext(token.EpsilonRune, final)
+	return nfa.epsilonClosure(next)
 }
 
-// initNumberLiteral initialize the states that can recognize number literal token
-func (nfa *NFA) initNumberLiteral() {
-	start := nfa.getNewState()
-	nfa.InitState.AddNext(token.EpsilonRune, start)
-	s := nfa.getNewState()
-
-	for _, c := range nfa.CharacterSet.GetDigits() {
-		start.AddNext(c, s)
-		s.AddNext(c, s)
+// getClosure returns the epsilon closure of the state, the closure is computed only once
+func (nfa *NFA) getClosure(s *State) []*State {
+	closure, ok := nfa.closures.Load(s)
+	if ok {
+		return closure.([]*State)
 	}
 
-	final := nfa.getNewFinalState(token.NumberLiteral)
-	s.AddNext(token.EpsilonRune, final)
-}
+	states := s.EpsilonMove()
+	nfa.closures.Store(s, states)
 
-// Print prints all the states
-func (nfa *NFA) Print() {
-	nfa.InitState.Print()
+	return states
 }
 
-// Match matches the given runes and returns proper token
-func (nfa *NFA) Match(runes []rune) *token.Token {
-	return nfa.match(nfa.InitState, constant.ZeroInt, runes)
-}
+// epsilonClosure returns the given states and all the states that can transit to by epsilon move,
+// each state will appear only once
+func (nfa *NFA) epsilonClosure(states []*State) []*State {
+	if len(states) == 1 {
+		return nfa.getClosure(states[constant.ZeroInt])
+	}
 
-func (nfa *NFA) match(s *State, i int, runes []rune) *token.Token {
-	if i == len(runes) {
-		// all input runes are matched, check the result
-		if s.IsFinal {
-			// final state found
-			return token.NewToken(s.TokenType, string(runes))
+	visited := make(map[int]bool)
+
+	var closure []*State
+	for _, s := range states {
+		if visited[s.Index] {
+			continue
 		}
-		// this state is not a final state, need to check if there is any ε-move that can transit to the final state
-		nsList := s.Next[token.EpsilonRune]
-		for _, ns := range nsList {
-			if ns.IsFinal {
-				// final state found
-				return token.NewToken(ns.TokenType, string(runes))
+		for _, state := range nfa.getClosure(s) {
+			if !visited[state.Index] {
+				visited[state.Index] = true
+				closure = append(closure, state)
 			}
 		}
-		// all input runes are matched, and there is no ε-move that can transit to the final state, return error token
-		return token.NewToken(token.Error, string(runes))
 	}
 
-	nsList := s.Next[runes[i]]
-	if nsList == nil {
-		nsList = s.Next[token.EpsilonRune]
-		if nsList == nil {
-			//  can't transit to any other state, return error token
-			return token.NewToken(token.Error, string(runes))
+	return closure
+}
+
+// getFinalState returns the final state which has the top priority among the given states,
+// it returns nil if there is no final state
+func getFinalState(states []*State) *State {
+	var final *State
+
+	for _, s := range states {
+		if s.IsFinal && (final == nil || s.hasPriority(final)) {
+			final = s
 		}
-	} else {
-		// matched an input rune, increase the matching index
-		i++
 	}
 
-	for _, ns := range nsList {
-		// match next rune recursively
-		t := nfa.match(ns, i, runes)
-		// if returning token is not an error token, it means matched some token,
-		// otherwise, means this is not a good path, need to try another one
-		if t.Type != token.Error {
-			return t
+	return final
+}
+
+// NewWalker returns a new walker of the NFA
+func (nfa *NFA) NewWalker() dependency.Walker {
+	return NewNFAWalker(nfa)
+}
+
+// mergeMultiRuneMaps merges the given maps into a new map
+func mergeMultiRuneMaps(maps ...map[token.Type]string) map[token.Type]string {
+	multiRuneMap := make(map[token.Type]string)
+	for _, m := range maps {
+		for tokenType, tokenString := range m {
+			multiRuneMap[tokenType] = tokenString
 		}
 	}
 
-	return token.NewToken(token.Error, string(runes))
+	return multiRuneMap
 }
 
 // getNewState gets a new state
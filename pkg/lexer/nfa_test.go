@@ -1,8 +1,11 @@
 package lexer
 
 import (
-	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -20,6 +23,7 @@ func initTestNFA() {
 func TestNFA_All(t *testing.T) {
 	TestNFA_Print(t)
 	TestNFA_Match(t)
+	TestNFA_Pathological(t)
 }
 
 func TestNFA_Print(t *testing.T) {
@@ -27,10 +31,69 @@ func TestNFA_Print(t *testing.T) {
 }
 
 func TestNFA_Match(t *testing.T) {
+	asst := assert.New(t)
+
 	strList := []string{"select", "and", "as", "selectt", "'string'", "123", "123abc", ">=", "123."}
+	expected := []token.Type{token.Select, token.And, token.As, token.Identifier, token.StringLiteral,
+		token.NumberLiteral, token.Identifier, token.GE, token.DecimalLiteral}
+
+	for i, str := range strList {
+		tk := testNFA.Match([]rune(str))
+		asst.Equal(expected[i], tk.Type, "test Match() failed. str: %s", str)
+		asst.Equal(str, tk.Lexeme, "test Match() failed. str: %s", str)
+	}
+}
+
+func TestNFA_Pathological(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	// the nested stars and the empty alternatives make epsilon cycles,
+	// the ambiguous alternatives make the number of the paths exponential in the length of the input
+	rules := []*Rule{
+		NewRule(token.Identifier, "(a*)*b", DefaultPriority),
+		NewRule(token.NumberLiteral, "(a|a)*c", DefaultPriority),
+		NewRule(token.StringLiteral, "((a?)*)*d", DefaultPriority),
+		NewRule(token.Comment, "(a*|b*)*", DefaultPriority),
+		NewRule(token.WhiteSpace, "(a|b|ab|ba)*(a|b|ab|ba)*e", DefaultPriority),
+	}
+	nfa, err := NewNFAWithRules(cs, rules)
+	asst.Nil(err, "test Match() failed")
+	dfa := NewDFAWithNFA(nfa)
 
-	for _, str := range strList {
-		token := testNFA.Match([]rune(str))
-		fmt.Println(token.String())
+	inputs := getTestInputs([]rune("abcde"), 5)
+	for _, c := range []string{"b", "c", "d", "e", "f"} {
+		inputs = append(inputs, strings.Repeat("a", 3000)+c, strings.Repeat("ab", 1500)+c)
 	}
+	for _, input := range inputs {
+		asst.Equal(dfa.Match([]rune(input)), nfa.Match([]rune(input)), "test Match() failed. input: %s", input)
+	}
+
+	// the simulation is linear if the work of the input of length 2n is not more than twice the work of the input of length n,
+	// the extra work of the last rune is bounded by the number of the states of the nfa
+	for _, c := range []string{"b", "c", "d", "e", "f"} {
+		for _, repeat := range []string{"a", "ab"} {
+			work := getNFAWork(nfa, strings.Repeat(repeat, 1000)+c)
+			doubleWork := getNFAWork(nfa, strings.Repeat(repeat, 2000)+c)
+			asst.LessOrEqual(doubleWork, 2*work+nfa.Index, "test Match() failed. the simulation is not linear. input: %s...%s", repeat, c)
+		}
+	}
+
+	sql := strings.Repeat("a", 3000) + "b abab abe"
+	asst.Equal(NewLexer(dfa).Lex(sql), NewLexer(nfa).Lex(sql), "test Lex() failed. sql: %s", sql)
+}
+
+// getNFAWork returns the number of the states which are visited by the nfa walker with the given input,
+// the states of each step are counted, so it is proportional to the time of the simulation
+func getNFAWork(nfa *NFA, input string) int {
+	w := NewNFAWalker(nfa)
+	work := len(w.states)
+	for _, c := range input {
+		if !w.Step(c) {
+			break
+		}
+		work += len(w.states)
+	}
+
+	return work
 }
@@ -0,0 +1,169 @@
+package lexer
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+
+	"github.com/romberli/go-util/constant"
+)
+
+// RuneRange is a closed interval of runes, a single rune c is the range c-c
+type RuneRange struct {
+	Start rune
+	End   rune
+}
+
+// NewRuneRange returns a new RuneRange
+func NewRuneRange(start, end rune) RuneRange {
+	return RuneRange{
+		Start: start,
+		End:   end,
+	}
+}
+
+// Contains returns if the given rune is in the range
+func (r RuneRange) Contains(c rune) bool {
+	return c >= r.Start && c <= r.End
+}
+
+// String returns the string representation of the range
+func (r RuneRange) String() string {
+	if r.Start == r.End {
+		return fmt.Sprintf("'%c'", r.Start)
+	}
+
+	return fmt.Sprintf("'%c'-'%c'", r.Start, r.End)
+}
+
+// getRangesOfRunes returns the sorted ranges which contain exactly the given runes
+func getRangesOfRunes(runes []rune) []RuneRange {
+	ranges := make([]RuneRange, len(runes))
+	for i, c := range runes {
+		ranges[i] = NewRuneRange(c, c)
+	}
+
+	return normalizeRanges(ranges)
+}
+
+// getRangesOfTable returns the sorted ranges which contain exactly the runes of the given range table
+func getRangesOfTable(table *unicode.RangeTable) []RuneRange {
+	var ranges []RuneRange
+
+	addRange := func(lo, hi, stride rune) {
+		if stride == 1 {
+			ranges = append(ranges, NewRuneRange(lo, hi))
+			return
+		}
+		for c := lo; c <= hi; c += stride {
+			ranges = append(ranges, NewRuneRange(c, c))
+		}
+	}
+	for _, r := range table.R16 {
+		addRange(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	for _, r := range table.R32 {
+		addRange(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+
+	return normalizeRanges(ranges)
+}
+
+// normalizeRanges sorts the ranges and merges the overlapping or adjacent ones,
+// so that the result contains the same runes with the fewest ranges
+func normalizeRanges(ranges []RuneRange) []RuneRange {
+	sorted := make([]RuneRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	var result []RuneRange
+	for _, r := range sorted {
+		last := len(result) - 1
+		if last >= constant.ZeroInt && r.Start <= result[last].End+1 {
+			if r.End > result[last].End {
+				result[last].End = r.End
+			}
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// subtractRanges returns the ranges which contain the runes of the universe which are not in the given ranges,
+// both the universe and the given ranges must be normalized
+func subtractRanges(universe, ranges []RuneRange) []RuneRange {
+	var result []RuneRange
+
+	i := constant.ZeroInt
+	for _, u := range universe {
+		start := u.Start
+		// skip the ranges which are before current universe range
+		for i < len(ranges) && ranges[i].End < start {
+			i++
+		}
+		for j := i; j < len(ranges) && ranges[j].Start <= u.End; j++ {
+			if ranges[j].Start > start {
+				result = append(result, NewRuneRange(start, ranges[j].Start-1))
+			}
+			if ranges[j].End >= u.End {
+				start = u.End + 1
+				break
+			}
+			start = ranges[j].End + 1
+		}
+		if start <= u.End {
+			result = append(result, NewRuneRange(start, u.End))
+		}
+	}
+
+	return result
+}
+
+// searchRange returns the index of the range which contains the given rune, the ranges must be sorted and disjoint,
+// it returns -1 if no range contains the rune
+func searchRange(ranges []RuneRange, c rune) int {
+	i := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].End >= c
+	})
+	if i < len(ranges) && ranges[i].Start <= c {
+		return i
+	}
+
+	return -1
+}
+
+// splitRanges returns the sorted and disjoint ranges which are split by the boundaries of all the given ranges,
+// each of the given ranges is exactly the union of some of the result ranges,
+// and the result ranges only contain the runes of the given ranges
+func splitRanges(ranges []RuneRange) []RuneRange {
+	if len(ranges) == constant.ZeroInt {
+		return nil
+	}
+
+	// the runes where a range starts or the runes right after a range ends
+	points := make([]rune, constant.ZeroInt, len(ranges)*2)
+	for _, r := range ranges {
+		points = append(points, r.Start, r.End+1)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i] < points[j]
+	})
+
+	covered := normalizeRanges(ranges)
+	var result []RuneRange
+	for i := 0; i < len(points)-1; i++ {
+		if points[i] == points[i+1] {
+			continue
+		}
+		// the runes between two adjacent points are either all covered or all not covered
+		if searchRange(covered, points[i]) >= constant.ZeroInt {
+			result = append(result, NewRuneRange(points[i], points[i+1]-1))
+		}
+	}
+
+	return result
+}
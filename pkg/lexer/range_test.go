@@ -0,0 +1,71 @@
+package lexer
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuneRange_All(t *testing.T) {
+	TestRuneRange_Normalize(t)
+	TestRuneRange_Subtract(t)
+	TestRuneRange_Split(t)
+	TestRuneRange_Table(t)
+}
+
+func TestRuneRange_Normalize(t *testing.T) {
+	asst := assert.New(t)
+
+	ranges := []RuneRange{{'x', 'z'}, {'a', 'c'}, {'b', 'd'}, {'e', 'e'}, {'0', '9'}}
+	expected := []RuneRange{{'0', '9'}, {'a', 'e'}, {'x', 'z'}}
+	asst.Equal(expected, normalizeRanges(ranges), "test normalizeRanges() failed")
+	asst.Equal([]RuneRange{{'a', 'c'}, {'z', 'z'}}, getRangesOfRunes([]rune("zcba")), "test getRangesOfRunes() failed")
+}
+
+func TestRuneRange_Subtract(t *testing.T) {
+	asst := assert.New(t)
+
+	universe := []RuneRange{{'a', 'z'}, {'0', '9'}, {0x80, unicode.MaxRune}}
+	universe = normalizeRanges(universe)
+	ranges := normalizeRanges([]RuneRange{{'0', '9'}, {'c', 'e'}, {'z', 0x100}})
+	expected := []RuneRange{{'a', 'b'}, {'f', 'y'}, {0x101, unicode.MaxRune}}
+	asst.Equal(expected, subtractRanges(universe, ranges), "test subtractRanges() failed")
+	asst.Equal(universe, subtractRanges(universe, nil), "test subtractRanges() failed")
+	asst.Nil(subtractRanges(universe, universe), "test subtractRanges() failed")
+}
+
+func TestRuneRange_Split(t *testing.T) {
+	asst := assert.New(t)
+
+	ranges := []RuneRange{{'a', 'z'}, {'c', 'c'}, {'x', 0xFFFF}, {'0', '0'}}
+	expected := []RuneRange{{'0', '0'}, {'a', 'b'}, {'c', 'c'}, {'d', 'w'}, {'x', 'z'}, {'z' + 1, 0xFFFF}}
+	split := splitRanges(ranges)
+	asst.Equal(expected, split, "test splitRanges() failed")
+	for _, c := range []rune{'0', 'a', 'c', 'y', '中', 0xFFFF} {
+		asst.GreaterOrEqual(searchRange(split, c), 0, "test searchRange() failed. rune: %c", c)
+	}
+	for _, c := range []rune{'1', '`', 0x10000} {
+		asst.Equal(-1, searchRange(split, c), "test searchRange() failed. rune: %c", c)
+	}
+}
+
+func TestRuneRange_Table(t *testing.T) {
+	asst := assert.New(t)
+
+	table := &unicode.RangeTable{
+		R16: []unicode.Range16{{Lo: 'a', Hi: 'c', Stride: 1}, {Lo: 'x', Hi: 'z', Stride: 2}},
+		R32: []unicode.Range32{{Lo: 0x10000, Hi: 0x10FFFF, Stride: 1}},
+	}
+	expected := []RuneRange{{'a', 'c'}, {'x', 'x'}, {'z', 'z'}, {0x10000, 0x10FFFF}}
+	asst.Equal(expected, getRangesOfTable(table), "test getRangesOfTable() failed")
+
+	cs := NewCharacterSetWithDefault()
+	alphabets := cs.GetAlphabetRanges()
+	for _, c := range []rune{'a', 'Z', '_', 'é', '中', 'ー', 0xFFFF} {
+		asst.GreaterOrEqual(searchRange(alphabets, c), 0, "test GetAlphabetRanges() failed. rune: %c", c)
+	}
+	for _, c := range []rune{'0', ' ', '$', 0x10000} {
+		asst.Equal(-1, searchRange(alphabets, c), "test GetAlphabetRanges() failed. rune: %c", c)
+	}
+}
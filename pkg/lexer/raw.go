@@ -0,0 +1,434 @@
+package lexer
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+// quoteRunes are the quotes which could open a string or a quoted identifier
+var quoteRunes = []rune{SingleQuoteRune, DoubleQuoteRune, BacktickRune}
+
+// errNeedMoreInput is returned by the raw scanner in partial mode when the token could not be decided until more input is fed
+var errNeedMoreInput = errors.New("need more input")
+
+// RawToken is a token which references the input by the byte offsets instead of holding the lexeme,
+// start is inclusive and end is exclusive, it is a value, so scanning the raw tokens does not allocate
+type RawToken struct {
+	Type  token.Type
+	Start int
+	End   int
+}
+
+// NewRawToken returns a new RawToken
+func NewRawToken(tokenType token.Type, start, end int) RawToken {
+	return RawToken{
+		Type:  tokenType,
+		Start: start,
+		End:   end,
+	}
+}
+
+// GetLexeme returns the lexeme of the raw token in the given input, the lexeme shares the memory with the input
+func (rt RawToken) GetLexeme(sql string) string {
+	return sql[rt.Start:rt.End]
+}
+
+// rawFrame is a mode in the mode stack of the raw scanner
+type rawFrame struct {
+	mode   *Mode
+	walker dependency.Walker
+	// limit is the end offset of the text which is scanned in the mode
+	limit int
+	// suffix is the suffix of the token which pushes the mode, it is returned after the content is fully scanned if it is not a trivia
+	suffix RawToken
+}
+
+// RawScanner scans the input string by the byte offsets and returns the raw tokens,
+// it never copies the input, so it does not allocate per token if the walker of the finite automata does not allocate,
+// e.g. the walkers of the DFA and the TableDFA, the scanner could be reused for another input by Reset
+type RawScanner struct {
+	mode          *Mode
+	sql           string
+	offset        int
+	skipComments  bool
+	serverVersion int
+	// stack is the mode stack, the bottom is the mode of the scanner
+	stack []rawFrame
+	// walkers caches the walkers of the modes, so that pushing a mode does not allocate
+	walkers map[*Mode]dependency.Walker
+	// partial is true if more input may follow the input, it is used by the Scanner which reads the input piece by piece
+	partial bool
+	// lossless is true if the trivia prefix and suffix of the tokens which push the modes are returned, it is used by the Scanner
+	lossless bool
+	// code is the diagnostic code of the last error token
+	code DiagnosticCode
+	// quote is the missing closing quote of the last error token if it is an unterminated string or quoted identifier
+	quote rune
+}
+
+// NewRawScanner returns a new *RawScanner with the given finite automata,
+// comments are skipped and the executable comments are executed on DefaultServerVersion by default
+func NewRawScanner(fa dependency.Lexer) *RawScanner {
+	return NewRawScannerWithMode(NewSQLMode(fa))
+}
+
+// NewRawScannerWithMode returns a new *RawScanner which scans the input in the given mode
+func NewRawScannerWithMode(mode *Mode) *RawScanner {
+	s := &RawScanner{
+		mode:          mode,
+		skipComments:  true,
+		serverVersion: DefaultServerVersion,
+		walkers:       make(map[*Mode]dependency.Walker),
+	}
+	s.Reset(constant.EmptyString)
+
+	return s
+}
+
+// AddModeRule adds a rule which pushes a mode from the mode of the scanner, e.g. NewHintRule()
+func (s *RawScanner) AddModeRule(rule *ModeRule) {
+	s.mode.AddRule(rule)
+}
+
+// SetSkipComments sets whether the comments are skipped
+func (s *RawScanner) SetSkipComments(skipComments bool) {
+	s.skipComments = skipComments
+}
+
+// SetServerVersion sets the server version, e.g. 80000 means 8.0.0,
+// the executable comment like /*!80000 ... */ is executed only if its version is not larger than the server version
+func (s *RawScanner) SetServerVersion(serverVersion int) {
+	s.serverVersion = serverVersion
+}
+
+// Reset resets the scanner to the beginning of the given input
+func (s *RawScanner) Reset(sql string) {
+	s.sql = sql
+	s.offset = constant.ZeroInt
+	s.partial = false
+	s.stack = append(s.stack[:constant.ZeroInt], rawFrame{mode: s.mode, walker: s.getWalker(s.mode), limit: len(sql)})
+}
+
+// feed appends the input after the current input in partial mode, the input before current offset is dropped,
+// so the offsets of the raw tokens which are returned later are relative to current offset,
+// it must be called only after errNeedMoreInput is returned, so that there is no pushed mode
+func (s *RawScanner) feed(input string, isEOF bool) {
+	s.sql = s.sql[s.offset:] + input
+	s.offset = constant.ZeroInt
+	s.partial = !isEOF
+	s.stack[constant.ZeroInt].limit = len(s.sql)
+}
+
+// needMoreInput returns true if the rune at the given offset is not fully fed in partial mode,
+// only the mode on the bottom scans the fed input, the content of a pushed mode is always complete
+func (s *RawScanner) needMoreInput(offset int) bool {
+	return s.partial && len(s.stack) == 1 && !utf8.FullRuneInString(s.sql[offset:])
+}
+
+// getWalker returns the walker of the given mode, the walker is created on first use
+func (s *RawScanner) getWalker(mode *Mode) dependency.Walker {
+	walker, ok := s.walkers[mode]
+	if !ok {
+		walker = mode.FA.NewWalker()
+		s.walkers[mode] = walker
+	}
+
+	return walker
+}
+
+// getTop returns the mode on the top of the mode stack
+func (s *RawScanner) getTop() *rawFrame {
+	return &s.stack[len(s.stack)-1]
+}
+
+// Lex scans the whole input, appends the raw tokens to the given slice and returns the extended slice,
+// passing the slice returned by the previous call with zero length reuses its memory
+func (s *RawScanner) Lex(sql string, tokens []RawToken) []RawToken {
+	s.Reset(sql)
+	for {
+		// scanning a string never fails, so the error is always io.EOF
+		rt, err := s.Next()
+		if err != nil {
+			return tokens
+		}
+		tokens = append(tokens, rt)
+	}
+}
+
+// Next scans the input and returns the next raw token, it returns io.EOF when there is no more token,
+// white spaces are skipped, comments are also skipped if skipComments is true
+func (s *RawScanner) Next() (RawToken, error) {
+	for {
+		rt, err := s.scanWithMode()
+		if err != nil {
+			return rt, err
+		}
+		if rt.Type == token.WhiteSpace || rt.Type == token.Comment && s.skipComments {
+			continue
+		}
+
+		return rt, nil
+	}
+}
+
+// scanWithMode returns the next raw token including the white spaces and the comments,
+// if the token pushes a mode, the content of it will be scanned in the pushed mode,
+// the prefix and the suffix of the token are not returned if they are trivia unless the scanner works for the lossless Scanner
+func (s *RawScanner) scanWithMode() (RawToken, error) {
+	for {
+		top := s.getTop()
+		if len(s.stack) > 1 && s.offset >= top.limit {
+			// the content is fully scanned, pop the mode
+			suffix := top.suffix
+			s.stack = s.stack[:len(s.stack)-1]
+			s.offset = suffix.End
+			if s.lossless || !suffix.Type.IsTrivia() {
+				return suffix, nil
+			}
+			continue
+		}
+
+		rt, err := s.scan()
+		if err != nil {
+			return rt, err
+		}
+		rule, prefix, content, ok := top.mode.match(rt.Type, rt.GetLexeme(s.sql), s.serverVersion)
+		if !ok {
+			return rt, nil
+		}
+
+		start := rt.Start + len(prefix)
+		end := start + len(content)
+		s.offset = start
+		s.stack = append(s.stack, rawFrame{
+			mode:   rule.Mode,
+			walker: s.getWalker(rule.Mode),
+			limit:  end,
+			suffix: NewRawToken(rule.EndType, end, rt.End),
+		})
+		if s.lossless || !rule.BeginType.IsTrivia() {
+			return NewRawToken(rule.BeginType, rt.Start, start), nil
+		}
+	}
+}
+
+// scan walks through the finite automata from current offset as far as possible,
+// and returns the raw token of the longest runes that reach a final state/set,
+// the runes after the longest match will be scanned again by the next token,
+// if the input ends inside an open quote or block comment, e.g. an unterminated string literal,
+// all the runes will be returned as an error token
+func (s *RawScanner) scan() (RawToken, error) {
+	var (
+		top         = s.getTop()
+		start       = s.offset
+		offset      = s.offset
+		matchedEnd  = s.offset
+		matchedType token.Type
+		isEOF       bool
+	)
+
+	top.walker.Reset()
+	for {
+		if s.needMoreInput(offset) {
+			return RawToken{}, errNeedMoreInput
+		}
+		if offset >= top.limit {
+			isEOF = true
+			break
+		}
+
+		c, size := s.decode(offset)
+		if !top.walker.Step(c) {
+			// can't transit to any other state/set, the rune belongs to the next token
+			break
+		}
+
+		offset += size
+		tokenType, ok := top.walker.GetTokenType()
+		if ok {
+			matchedEnd = offset
+			matchedType = tokenType
+		}
+	}
+
+	code, quote := InvalidToken, rune(constant.ZeroInt)
+	if isEOF && matchedEnd < offset {
+		code, quote = s.getUnterminatedCode(s.sql[start:offset])
+	}
+	if code != InvalidToken {
+		// the input ends while the runes are not terminated, e.g. a string literal without the closing quote
+		if code != UnterminatedComment {
+			// the rest of the line is treated as the unterminated text, the scanner resynchronizes at the next line
+			i := strings.IndexRune(s.sql[start:offset], NewLineRune)
+			if i >= constant.ZeroInt {
+				offset = start + i
+			}
+		}
+		rt := s.newErrorToken(code, offset)
+		s.quote = quote
+
+		return rt, nil
+	}
+
+	if isEOF && s.sql[start:offset] == doubleDashCommentPrefix {
+		// the -- at the end of the input is a comment
+		return s.newToken(token.Comment, offset), nil
+	}
+
+	if matchedEnd > start {
+		// the runes after the longest match will be scanned again by the next token
+		if matchedType == token.Error {
+			// the runes match a rule of the malformed token, e.g. x'123'
+			return s.newErrorToken(getInvalidCode(s.sql[start:matchedEnd]), matchedEnd), nil
+		}
+
+		return s.newToken(matchedType, matchedEnd), nil
+	}
+
+	if offset > start {
+		// the runes could not reach any final state/set
+		return s.newErrorToken(InvalidToken, offset), nil
+	}
+
+	if start >= top.limit {
+		return RawToken{}, io.EOF
+	}
+
+	// the rune could not be the beginning of any token,
+	// the following runes which could not be the beginning of any token either are merged into the same error token
+	_, size := s.decode(start)
+	offset += size
+	for {
+		if s.needMoreInput(offset) {
+			return RawToken{}, errNeedMoreInput
+		}
+		if offset >= top.limit {
+			break
+		}
+
+		c, size := s.decode(offset)
+		top.walker.Reset()
+		if top.walker.Step(c) {
+			// the scanner resynchronizes at the beginning of the next token
+			break
+		}
+		offset += size
+	}
+
+	return s.newErrorToken(UnknownCharacter, offset), nil
+}
+
+// getUnterminatedCode returns the diagnostic code and the missing closing quote of the lexeme which is not terminated when the input ends,
+// the lexeme is walked through again and closed by each quote in turn, the token type of the closed lexeme decides the code,
+// e.g. the double-quoted text is a quoted identifier if the finite automata is built with ANSI_QUOTES,
+// it returns InvalidToken if the lexeme is neither in an open quote nor in an open block comment
+func (s *RawScanner) getUnterminatedCode(lexeme string) (DiagnosticCode, rune) {
+	if strings.HasPrefix(lexeme, blockCommentPrefix) {
+		return UnterminatedComment, constant.ZeroInt
+	}
+
+	walker := s.getTop().walker
+	for _, quote := range quoteRunes {
+		if !strings.ContainsRune(lexeme, quote) {
+			continue
+		}
+		tokenType, ok := closeWithQuote(walker, lexeme, quote)
+		if !ok {
+			continue
+		}
+		if tokenType == token.QuotedIdentifier {
+			return UnterminatedQuotedIdentifier, quote
+		}
+
+		return UnterminatedString, quote
+	}
+
+	return InvalidToken, constant.ZeroInt
+}
+
+// closeWithQuote walks through the lexeme and the closing quote, and returns the token type of the final state/set it reaches,
+// the quote is stepped once more if the first one does not close the lexeme, e.g. the last rune of the lexeme is a backslash
+func closeWithQuote(walker dependency.Walker, lexeme string, quote rune) (token.Type, bool) {
+	walker.Reset()
+	for _, c := range lexeme {
+		if !walker.Step(c) {
+			return token.Error, false
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if !walker.Step(quote) {
+			return token.Error, false
+		}
+		tokenType, ok := walker.GetTokenType()
+		if ok {
+			return tokenType, true
+		}
+	}
+
+	return token.Error, false
+}
+
+// decode decodes the rune at the given offset, the invalid utf-8 byte is decoded as utf8.RuneError of size 1
+func (s *RawScanner) decode(offset int) (rune, int) {
+	return utf8.DecodeRuneInString(s.sql[offset:s.getTop().limit])
+}
+
+// newToken returns a new raw token from current offset to the given end and advances the offset of the scanner
+func (s *RawScanner) newToken(tokenType token.Type, end int) RawToken {
+	rt := NewRawToken(tokenType, s.offset, end)
+	s.offset = end
+
+	return rt
+}
+
+// newErrorToken returns a new error token from current offset to the given end and records the diagnostic code of it
+func (s *RawScanner) newErrorToken(code DiagnosticCode, end int) RawToken {
+	s.code = code
+	s.quote = constant.ZeroInt
+
+	return s.newToken(token.Error, end)
+}
+
+// NewTokens returns the tokens of the raw tokens which are scanned from the given input,
+// the spans and the values of the tokens are also set, the raw tokens must be in ascending order of the offsets,
+// the invalid utf-8 bytes of the lexemes are replaced by utf8.RuneError just like the Scanner does
+func NewTokens(sql string, rawTokens []RawToken) []*token.Token {
+	if len(rawTokens) == constant.ZeroInt {
+		return nil
+	}
+
+	tokens := make([]*token.Token, len(rawTokens))
+	pos := token.NewPositionWithDefault()
+	for i, rt := range rawTokens {
+		start := advanceBytes(pos, sql[pos.Offset:rt.Start])
+		lexeme := rt.GetLexeme(sql)
+		pos = advanceBytes(start, lexeme)
+		if !utf8.ValidString(lexeme) {
+			lexeme = string([]rune(lexeme))
+		}
+
+		t := token.NewTokenWithSpan(rt.Type, lexeme, token.NewSpan(start, pos))
+		t.SetValue(GetValue(rt.Type, t.Lexeme))
+		tokens[i] = t
+	}
+
+	return tokens
+}
+
+// advanceBytes returns the position after the given string, each invalid utf-8 byte is counted as a rune
+func advanceBytes(pos token.Position, s string) token.Position {
+	for len(s) > constant.ZeroInt {
+		c, size := utf8.DecodeRuneInString(s)
+		pos = pos.AdvanceBytes(c, size)
+		s = s[size:]
+	}
+
+	return pos
+}
@@ -0,0 +1,126 @@
+package lexer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawScanner_All(t *testing.T) {
+	TestRawScanner_Lex(t)
+	TestRawScanner_NewTokens(t)
+	TestRawScanner_Allocs(t)
+}
+
+func TestRawScanner_Lex(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select /*!80000 a, */ b /* c */ from t01 where d = 'e'"
+	l := NewLexer(testDFA)
+	rawTokens := l.LexRaw(sql, nil)
+	expected := []token.Type{token.Select, token.Identifier, token.Comma, token.Identifier, token.From, token.Identifier, token.Where, token.Identifier, token.Equal, token.StringLiteral}
+	asst.Equal(len(expected), len(rawTokens), "test LexRaw() failed. sql: %s", sql)
+	for i, rt := range rawTokens {
+		asst.Equal(expected[i], rt.Type, "test LexRaw() failed. sql: %s", sql)
+	}
+	asst.Equal(NewRawToken(token.Identifier, 16, 17), rawTokens[1], "test LexRaw() failed. sql: %s", sql)
+	asst.Equal("'e'", rawTokens[len(rawTokens)-1].GetLexeme(sql), "test LexRaw() failed. sql: %s", sql)
+
+	// the given slice is reused
+	buffer := make([]RawToken, 0, len(rawTokens))
+	rawTokens = l.LexRaw(sql, buffer)
+	asst.Same(&buffer[:1][0], &rawTokens[0], "test LexRaw() failed. sql: %s", sql)
+
+	s := l.NewRawScanner()
+	s.SetSkipComments(false)
+	s.SetServerVersion(50000)
+	rawTokens = s.Lex(sql, rawTokens[:0])
+	asst.Equal(token.Comment, rawTokens[1].Type, "test Lex() failed. sql: %s", sql)
+	asst.Equal("/*!80000 a, */", rawTokens[1].GetLexeme(sql), "test Lex() failed. sql: %s", sql)
+	asst.Equal(token.Comment, rawTokens[3].Type, "test Lex() failed. sql: %s", sql)
+}
+
+func TestRawScanner_NewTokens(t *testing.T) {
+	asst := assert.New(t)
+
+	sqls := []string{
+		testBenchmarkSQL,
+		"",
+		"  \n ",
+		"select /*!80000 a, */ b -- comment\nfrom t01 # comment",
+		"select /*!99999 a, */ b, /*! c */ d, /*!80000 e",
+		"select 'unterminated\nfrom t01",
+		"select `a\nb",
+		"select a /* unterminated\n comment",
+		"select 中文, 'é' from t01 where a ~ ^ b",
+		"select \x80\xff a from t01 where b = 'c\xfe'",
+		"select 123abc, 0x1g, 1.5e-3 from t01;",
+		"select /*!80000 \xff a */ b",
+	}
+	for _, skipComments := range []bool{true, false} {
+		for _, l := range []*Lexer{NewLexer(testNFA), NewLexer(testDFA), NewLexer(testTableDFA)} {
+			l.SetSkipComments(skipComments)
+			for _, sql := range sqls {
+				expected, _ := l.LexWithDiagnostics(sql)
+				asst.Equal(expected, NewTokens(sql, l.LexRaw(sql, nil)), "test NewTokens() failed. sql: %q", sql)
+			}
+		}
+	}
+
+	// the offsets of the content of the executable comment are the offsets of the source bytes
+	sql := "select /*!80000 \xff a */ b"
+	for _, tokens := range [][]*token.Token{testDFALexer.Lex(sql), testScannerTokens(sql)} {
+		if asst.Equal(4, len(tokens), "test NewTokens() failed. sql: %q", sql) {
+			asst.Equal("a", tokens[2].Lexeme, "test NewTokens() failed. sql: %q", sql)
+			asst.Equal(18, tokens[2].Span.Start.Offset, "test NewTokens() failed. sql: %q", sql)
+			asst.Equal(19, tokens[2].Span.End.Offset, "test NewTokens() failed. sql: %q", sql)
+		}
+	}
+}
+
+// testScannerTokens returns the tokens of the sql which are scanned by the scanner of testDFALexer
+func testScannerTokens(sql string) []*token.Token {
+	tokens, _ := testDFALexer.LexWithDiagnostics(sql)
+
+	return tokens
+}
+
+func TestRawScanner_Allocs(t *testing.T) {
+	asst := assert.New(t)
+
+	// the hints and the executable comments push the modes
+	hintLexer := NewLexer(testTableDFA)
+	hintLexer.AddModeRule(NewHintRule())
+	sql := strings.Repeat("select /*+ BKA(t1) */ a, /*!80000 b, */ c from t1;\n", 1024) + getBenchmarkSQL()
+	for _, l := range []*Lexer{testDFALexer, testTableDFALexer, hintLexer} {
+		s := l.NewRawScanner()
+		rawTokens := s.Lex(sql, nil)
+		allocs := testing.AllocsPerRun(10, func() {
+			rawTokens = s.Lex(sql, rawTokens[:0])
+		})
+		asst.Zero(allocs, "test Lex() failed")
+	}
+}
+
+func benchmarkLexRaw(b *testing.B, l *Lexer) {
+	sql := getBenchmarkSQL()
+	s := l.NewRawScanner()
+	rawTokens := s.Lex(sql, nil)
+	b.SetBytes(int64(len(sql)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		rawTokens = s.Lex(sql, rawTokens[:0])
+	}
+}
+
+func BenchmarkDFA_LexRaw(b *testing.B) {
+	benchmarkLexRaw(b, testDFALexer)
+}
+
+func BenchmarkTableDFA_LexRaw(b *testing.B) {
+	benchmarkLexRaw(b, testTableDFALexer)
+}
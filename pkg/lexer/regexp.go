@@ -0,0 +1,448 @@
+package lexer
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// regular expression meta runes
+	alternationRune      = '|'
+	starRune             = '*'
+	plusRune             = '+'
+	questionRune         = '?'
+	leftParenthesisRune  = '('
+	rightParenthesisRune = ')'
+	leftBracketRune      = '['
+	rightBracketRune     = ']'
+	caretRune            = '^'
+	hyphenRune           = '-'
+	backslashRune        = '\\'
+	dotRune              = '.'
+	leftBraceRune        = '{'
+	rightBraceRune       = '}'
+	hexEscapeRune        = 'x'
+
+	regexpMetaRunes = `|*+?()[]\.`
+	classMetaRunes  = `]^-\`
+)
+
+type regexpKind int
+
+const (
+	regexpRunes regexpKind = iota + 1
+	regexpConcatenation
+	regexpAlternation
+	regexpStar
+	regexpPlus
+	regexpQuestion
+)
+
+// regexpNode is a node of the syntax tree of the regular expression
+type regexpNode struct {
+	kind     regexpKind
+	ranges   []RuneRange
+	children []*regexpNode
+}
+
+// regexpParser parses the regular expression dialect which supports:
+//   - concatenation: ab
+//   - alternation: a|b
+//   - repetition: a*, a+, a?
+//   - group: (ab)
+//   - character class: [abc], [a-z], [^abc], the negated class matches the runes of the universe which are not in the class
+//   - any rune: ., it matches any rune of the universe
+//   - escape: \t, \r, \n, \x{4e2d} which is the rune of the hexadecimal code point, and any meta rune like \*
+type regexpParser struct {
+	runes    []rune
+	index    int
+	universe []RuneRange
+}
+
+// parseRegexp parses the pattern and returns the syntax tree, the universe must be normalized
+func parseRegexp(pattern string, universe []RuneRange) (*regexpNode, error) {
+	p := &regexpParser{
+		runes:    []rune(pattern),
+		universe: universe,
+	}
+
+	node, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isEnd() {
+		return nil, errors.Errorf("unexpected rune '%c' at position %d of pattern %s", p.peek(), p.index, pattern)
+	}
+
+	return node, nil
+}
+
+// isEnd returns if all the runes of the pattern are parsed
+func (p *regexpParser) isEnd() bool {
+	return p.index >= len(p.runes)
+}
+
+// peek returns the current rune without consuming it
+func (p *regexpParser) peek() rune {
+	return p.runes[p.index]
+}
+
+// next consumes and returns the current rune
+func (p *regexpParser) next() rune {
+	c := p.runes[p.index]
+	p.index++
+
+	return c
+}
+
+// parseAlternation parses: concatenation ('|' concatenation)*
+func (p *regexpParser) parseAlternation() (*regexpNode, error) {
+	node, err := p.parseConcatenation()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []*regexpNode{node}
+	for !p.isEnd() && p.peek() == alternationRune {
+		p.next()
+		node, err = p.parseConcatenation()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, node)
+	}
+
+	if len(children) == 1 {
+		return children[constant.ZeroInt], nil
+	}
+
+	return &regexpNode{kind: regexpAlternation, children: children}, nil
+}
+
+// parseConcatenation parses: repetition*
+func (p *regexpParser) parseConcatenation() (*regexpNode, error) {
+	var children []*regexpNode
+
+	for !p.isEnd() && p.peek() != alternationRune && p.peek() != rightParenthesisRune {
+		node, err := p.parseRepetition()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, node)
+	}
+
+	if len(children) == 1 {
+		return children[constant.ZeroInt], nil
+	}
+
+	// an empty concatenation matches the empty string
+	return &regexpNode{kind: regexpConcatenation, children: children}, nil
+}
+
+// parseRepetition parses: atom ('*' | '+' | '?')*
+func (p *regexpParser) parseRepetition() (*regexpNode, error) {
+	node, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+
+	for !p.isEnd() {
+		switch p.peek() {
+		case starRune:
+			node = &regexpNode{kind: regexpStar, children: []*regexpNode{node}}
+		case plusRune:
+			node = &regexpNode{kind: regexpPlus, children: []*regexpNode{node}}
+		case questionRune:
+			node = &regexpNode{kind: regexpQuestion, children: []*regexpNode{node}}
+		default:
+			return node, nil
+		}
+		p.next()
+	}
+
+	return node, nil
+}
+
+// parseAtom parses: '(' alternation ')' | '[' class ']' | '.' | escape | rune
+func (p *regexpParser) parseAtom() (*regexpNode, error) {
+	c := p.next()
+
+	switch c {
+	case leftParenthesisRune:
+		node, err := p.parseAlternation()
+		if err != nil {
+			return nil, err
+		}
+		if p.isEnd() || p.next() != rightParenthesisRune {
+			return nil, errors.Errorf("missing '%c' at position %d of pattern %s", rightParenthesisRune, p.index, string(p.runes))
+		}
+
+		return node, nil
+	case leftBracketRune:
+		return p.parseClass()
+	case dotRune:
+		if len(p.universe) == constant.ZeroInt {
+			return nil, errors.Errorf("the universe of '%c' is empty in pattern %s", dotRune, string(p.runes))
+		}
+
+		return &regexpNode{kind: regexpRunes, ranges: p.universe}, nil
+	case backslashRune:
+		c, err := p.parseEscape()
+		if err != nil {
+			return nil, err
+		}
+
+		return &regexpNode{kind: regexpRunes, ranges: []RuneRange{NewRuneRange(c, c)}}, nil
+	case starRune, plusRune, questionRune, rightParenthesisRune, rightBracketRune:
+		return nil, errors.Errorf("unexpected rune '%c' at position %d of pattern %s", c, p.index-1, string(p.runes))
+	default:
+		return &regexpNode{kind: regexpRunes, ranges: []RuneRange{NewRuneRange(c, c)}}, nil
+	}
+}
+
+// parseClass parses the character class after '['
+func (p *regexpParser) parseClass() (*regexpNode, error) {
+	var (
+		isNegated bool
+		ranges    []RuneRange
+	)
+
+	if !p.isEnd() && p.peek() == caretRune {
+		isNegated = true
+		p.next()
+	}
+
+	for {
+		if p.isEnd() {
+			return nil, errors.Errorf("missing '%c' of the character class in pattern %s", rightBracketRune, string(p.runes))
+		}
+
+		c := p.next()
+		if c == rightBracketRune {
+			break
+		}
+		if c == backslashRune {
+			var err error
+			c, err = p.parseEscape()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if p.index+1 < len(p.runes) && p.peek() == hyphenRune && p.runes[p.index+1] != rightBracketRune {
+			// this is a range
+			p.next()
+			end := p.next()
+			if end == backslashRune {
+				var err error
+				end, err = p.parseEscape()
+				if err != nil {
+					return nil, err
+				}
+			}
+			if end < c {
+				return nil, errors.Errorf("invalid range %c-%c in pattern %s", c, end, string(p.runes))
+			}
+			ranges = append(ranges, NewRuneRange(c, end))
+			continue
+		}
+
+		ranges = append(ranges, NewRuneRange(c, c))
+	}
+
+	ranges = normalizeRanges(ranges)
+	if isNegated {
+		ranges = subtractRanges(p.universe, ranges)
+	}
+	if len(ranges) == constant.ZeroInt {
+		return nil, errors.Errorf("empty character class in pattern %s", string(p.runes))
+	}
+
+	return &regexpNode{kind: regexpRunes, ranges: ranges}, nil
+}
+
+// parseEscape parses the rune after '\'
+func (p *regexpParser) parseEscape() (rune, error) {
+	if p.isEnd() {
+		return constant.ZeroInt, errors.Errorf("missing rune after '%c' in pattern %s", backslashRune, string(p.runes))
+	}
+
+	c := p.next()
+	switch c {
+	case 't':
+		return TabRune, nil
+	case 'r':
+		return ReturnRune, nil
+	case 'n':
+		return NewLineRune, nil
+	case hexEscapeRune:
+		return p.parseHexEscape()
+	default:
+		return c, nil
+	}
+}
+
+// parseHexEscape parses the hexadecimal code point after '\x', the code point must be enclosed in braces, e.g. \x{4e2d}
+func (p *regexpParser) parseHexEscape() (rune, error) {
+	if p.isEnd() || p.next() != leftBraceRune {
+		return constant.ZeroInt, errors.Errorf("missing '%c' after '%c%c' in pattern %s", leftBraceRune, backslashRune, hexEscapeRune, string(p.runes))
+	}
+
+	start := p.index
+	for !p.isEnd() && p.peek() != rightBraceRune {
+		p.next()
+	}
+	if p.isEnd() {
+		return constant.ZeroInt, errors.Errorf("missing '%c' after '%c%c' in pattern %s", rightBraceRune, backslashRune, hexEscapeRune, string(p.runes))
+	}
+	digits := string(p.runes[start:p.index])
+	p.next()
+
+	code, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || code > unicode.MaxRune {
+		return constant.ZeroInt, errors.Errorf("invalid code point %s in pattern %s", digits, string(p.runes))
+	}
+
+	return rune(code), nil
+}
+
+// compileRegexp compiles the syntax tree into the states with Thompson's construction,
+// it returns the start state and the end state of the fragment
+func (nfa *NFA) compileRegexp(node *regexpNode) (*State, *State) {
+	start := nfa.getNewState()
+
+	switch node.kind {
+	case regexpRunes:
+		end := nfa.getNewState()
+		for _, r := range node.ranges {
+			start.AddRangeNext(r, end)
+		}
+
+		return start, end
+	case regexpConcatenation:
+		end := start
+		for _, child := range node.children {
+			childStart, childEnd := nfa.compileRegexp(child)
+			end.AddNext(token.EpsilonRune, childStart)
+			end = childEnd
+		}
+
+		return start, end
+	case regexpAlternation:
+		end := nfa.getNewState()
+		for _, child := range node.children {
+			childStart, childEnd := nfa.compileRegexp(child)
+			start.AddNext(token.EpsilonRune, childStart)
+			childEnd.AddNext(token.EpsilonRune, end)
+		}
+
+		return start, end
+	case regexpStar, regexpPlus, regexpQuestion:
+		end := nfa.getNewState()
+		childStart, childEnd := nfa.compileRegexp(node.children[constant.ZeroInt])
+		start.AddNext(token.EpsilonRune, childStart)
+		childEnd.AddNext(token.EpsilonRune, end)
+		if node.kind != regexpPlus {
+			// the child may not appear
+			start.AddNext(token.EpsilonRune, end)
+		}
+		if node.kind != regexpQuestion {
+			// the child may repeat
+			childEnd.AddNext(token.EpsilonRune, childStart)
+		}
+
+		return start, end
+	}
+
+	return start, start
+}
+
+// QuoteMeta returns a pattern that matches the literal string
+func QuoteMeta(s string) string {
+	var builder strings.Builder
+
+	for _, c := range s {
+		if strings.ContainsRune(regexpMetaRunes, c) {
+			builder.WriteRune(backslashRune)
+		}
+		builder.WriteRune(c)
+	}
+
+	return builder.String()
+}
+
+// QuoteMetaIgnoreCase returns a pattern that matches the literal string case-insensitively
+func QuoteMetaIgnoreCase(s string) string {
+	var builder strings.Builder
+
+	for _, c := range s {
+		lower := unicode.ToLower(c)
+		upper := unicode.ToUpper(c)
+		if lower == upper {
+			builder.WriteString(QuoteMeta(string(c)))
+			continue
+		}
+		builder.WriteString(getClassPattern([]rune{lower, upper}))
+	}
+
+	return builder.String()
+}
+
+// getClassPattern returns a character class pattern that matches any of the given runes
+func getClassPattern(runes []rune) string {
+	var builder strings.Builder
+
+	builder.WriteRune(leftBracketRune)
+	for _, c := range runes {
+		writeClassRune(&builder, c)
+	}
+	builder.WriteRune(rightBracketRune)
+
+	return builder.String()
+}
+
+// getRangesPattern returns a character class pattern that matches any rune of the given ranges
+func getRangesPattern(ranges []RuneRange) string {
+	var builder strings.Builder
+
+	builder.WriteRune(leftBracketRune)
+	for _, r := range ranges {
+		writeClassRune(&builder, r.Start)
+		if r.End != r.Start {
+			builder.WriteRune(hyphenRune)
+			writeClassRune(&builder, r.End)
+		}
+	}
+	builder.WriteRune(rightBracketRune)
+
+	return builder.String()
+}
+
+// writeClassRune writes the rune of the character class, the meta runes are escaped,
+// and the runes which are not printable ascii are written as the hexadecimal code points
+func writeClassRune(builder *strings.Builder, c rune) {
+	switch {
+	case strings.ContainsRune(classMetaRunes, c):
+		builder.WriteRune(backslashRune)
+		builder.WriteRune(c)
+	case c == TabRune:
+		builder.WriteString(`\t`)
+	case c == ReturnRune:
+		builder.WriteString(`\r`)
+	case c == NewLineRune:
+		builder.WriteString(`\n`)
+	case c < printableStart || c > printableEnd:
+		builder.WriteRune(backslashRune)
+		builder.WriteRune(hexEscapeRune)
+		builder.WriteRune(leftBraceRune)
+		builder.WriteString(strconv.FormatInt(int64(c), 16))
+		builder.WriteRune(rightBraceRune)
+	default:
+		builder.WriteRune(c)
+	}
+}
@@ -0,0 +1,185 @@
+package lexer
+
+import (
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testRegistered       bool
+	testHintKeyword      token.Type
+	testFatArrowOperator token.Type
+	testVendorTag        token.Type
+	testRegisterErrs     []error
+)
+
+// registerTestTypes registers the token types of the tests, they are unregistered when the test finishes,
+// so that the default lexers which are built by the other tests do not recognize them
+func registerTestTypes(t *testing.T) {
+	if testRegistered {
+		return
+	}
+	testRegistered = true
+
+	var err error
+	testRegisterErrs = nil
+	testHintKeyword, err = token.RegisterKeyword("vendor_hint", false)
+	testRegisterErrs = append(testRegisterErrs, err)
+	testFatArrowOperator, err = token.RegisterOperator("fatArrow", "=>>")
+	testRegisterErrs = append(testRegisterErrs, err)
+	testVendorTag, err = token.RegisterType("vendorTag", 0, "", false)
+	testRegisterErrs = append(testRegisterErrs, err)
+	testRegisterErrs = append(testRegisterErrs, RegisterRule(NewRule(testVendorTag, "%%[a-z]+", DefaultPriority)))
+
+	t.Cleanup(func() {
+		UnregisterRules(testVendorTag)
+		for _, tokenType := range []token.Type{testVendorTag, testFatArrowOperator, testHintKeyword} {
+			err := token.UnregisterType(tokenType)
+			if err != nil {
+				t.Errorf("unregister token type failed. token type: %d, error: %s", tokenType, err.Error())
+			}
+		}
+		testRegistered = false
+	})
+}
+
+func TestRegistry_All(t *testing.T) {
+	TestRegistry_BuiltIn(t)
+	TestRegistry_Register(t)
+	TestRegistry_Lex(t)
+	TestRegistry_Invalid(t)
+	TestRegistry_Unregister(t)
+}
+
+func TestRegistry_BuiltIn(t *testing.T) {
+	asst := assert.New(t)
+
+	// the built-in token types keep their values, the new built-in token types must be appended after the keywords
+	asst.Equal(token.Type(1), token.Select, "test BuiltIn failed")
+	asst.Equal(token.Type(7), token.Identifier, "test BuiltIn failed")
+	asst.Equal(token.Type(51), token.Comment, "test BuiltIn failed")
+	asst.Equal(token.Type(55), token.Error, "test BuiltIn failed")
+	asst.Equal(token.Type(56), token.AccessibleKeyword, "test BuiltIn failed")
+	asst.Equal(token.Type(796), token.ZoneKeyword, "test BuiltIn failed")
+	asst.Equal(token.Type(797), token.HintBegin, "test BuiltIn failed")
+	asst.Equal(token.Type(798), token.HintEnd, "test BuiltIn failed")
+
+	testCases := []struct {
+		tokenType token.Type
+		name      string
+		category  token.Category
+	}{
+		{token.Select, "selectKeyword", token.KeywordCategory},
+		{token.Identifier, "identifier", 0},
+		{token.NotEqual2, "notEqual", token.OperatorCategory},
+		{token.DivKeyword, "divKeyword", token.KeywordCategory | token.OperatorCategory},
+		{token.Epsilon, "ε", 0},
+		{token.YearMonthKeyword, "year_monthKeyword", token.KeywordCategory},
+		{token.HintBegin, "hintBegin", 0},
+	}
+	for _, tc := range testCases {
+		info, ok := token.GetTypeInfo(tc.tokenType)
+		asst.True(ok, "test GetTypeInfo() failed. token type: %d", tc.tokenType)
+		asst.Equal(tc.name, info.Name, "test GetTypeInfo() failed. token type: %d", tc.tokenType)
+		asst.Equal(tc.name, tc.tokenType.String(), "test String() failed. token type: %d", tc.tokenType)
+		asst.Equal(tc.category, info.Category, "test GetTypeInfo() failed. token type: %d", tc.tokenType)
+	}
+
+	_, ok := token.GetTypeInfo(0)
+	asst.False(ok, "test GetTypeInfo() failed")
+	asst.Equal("unknown", token.Type(-1).String(), "test String() failed")
+}
+
+func TestRegistry_Register(t *testing.T) {
+	asst := assert.New(t)
+
+	registerTestTypes(t)
+	for _, err := range testRegisterErrs {
+		asst.Nil(err, "test Register() failed")
+	}
+
+	asst.Greater(int(testHintKeyword), int(token.ZoneKeyword), "test Register() failed")
+	asst.Greater(int(testFatArrowOperator), int(testHintKeyword), "test Register() failed")
+	asst.Equal("vendor_hintKeyword", testHintKeyword.String(), "test Register() failed")
+	asst.True(testHintKeyword.IsKeyword(), "test Register() failed")
+	asst.False(testHintKeyword.IsReserved(), "test Register() failed")
+	tokenType, reserved := token.LookupKeyword("VENDOR_HINT")
+	asst.Equal(testHintKeyword, tokenType, "test LookupKeyword() failed")
+	asst.False(reserved, "test LookupKeyword() failed")
+	asst.True(testFatArrowOperator.IsOperator(), "test Register() failed")
+	asst.Equal([]token.Type{testVendorTag}, token.LookupTypes("vendorTag"), "test LookupTypes() failed")
+}
+
+func TestRegistry_Lex(t *testing.T) {
+	asst := assert.New(t)
+
+	registerTestTypes(t)
+	// the lexers must be built after registering
+	nfa := NewNFAWithDefault()
+	lexers := []*Lexer{NewLexer(nfa), NewLexer(NewDFAWithNFA(nfa))}
+
+	sql := "select /*+ x */ Vendor_Hint a =>> b, vendor_hints %%tag % c from t01"
+	expected := []token.Type{
+		token.Select, testHintKeyword, token.Identifier, testFatArrowOperator, token.Identifier, token.Comma,
+		token.Identifier, testVendorTag, token.Mod, token.Identifier, token.From, token.Identifier,
+	}
+	for _, l := range lexers {
+		tokens, diagnostics := l.LexWithDiagnostics(sql)
+		asst.Equal(expected, getTokenTypes(tokens), "test Lex() failed. sql: %s", sql)
+		asst.Empty(diagnostics, "test Lex() failed. sql: %s", sql)
+	}
+
+	// the lexers which are built before registering do not recognize the registered token types
+	tokens := testDFALexer.Lex("a =>> b")
+	asst.Equal([]token.Type{token.Identifier, token.Equal, token.RightShift, token.Identifier}, getTokenTypes(tokens), "test Lex() failed")
+}
+
+func TestRegistry_Invalid(t *testing.T) {
+	asst := assert.New(t)
+
+	registerTestTypes(t)
+
+	_, err := token.RegisterKeyword("select", true)
+	asst.NotNil(err, "test Register() failed")
+	_, err = token.RegisterKeyword("vendor hint", true)
+	asst.NotNil(err, "test Register() failed")
+	_, err = token.RegisterKeyword("", true)
+	asst.NotNil(err, "test Register() failed")
+	_, err = token.RegisterOperator("identifier", "=>")
+	asst.NotNil(err, "test Register() failed")
+	_, err = token.RegisterOperator("vendorEmpty", "")
+	asst.NotNil(err, "test Register() failed")
+	_, err = token.RegisterType(" ", 0, "", false)
+	asst.NotNil(err, "test Register() failed")
+
+	err = RegisterRule(NewRule(token.Type(1<<20), "abc", DefaultPriority))
+	asst.NotNil(err, "test RegisterRule() failed")
+	err = RegisterRule(NewRule(testVendorTag, "(abc", DefaultPriority))
+	asst.NotNil(err, "test RegisterRule() failed")
+	asst.NotNil(token.UnregisterType(token.Select), "test UnregisterType() failed")
+}
+
+func TestRegistry_Unregister(t *testing.T) {
+	asst := assert.New(t)
+
+	tokenType, err := token.RegisterOperator("vendorArrow", "~>>")
+	asst.Nil(err, "test Unregister failed")
+	err = RegisterRule(NewRule(tokenType, "~~[a-z]+", DefaultPriority))
+	asst.Nil(err, "test Unregister failed")
+
+	UnregisterRules(tokenType)
+	err = token.UnregisterType(tokenType)
+	asst.Nil(err, "test Unregister failed")
+	err = token.UnregisterType(tokenType)
+	asst.NotNil(err, "test Unregister failed")
+
+	// the lexers which are built after unregistering do not recognize the unregistered token types
+	asst.Empty(token.LookupTypes("vendorArrow"), "test Unregister failed")
+	for _, rule := range getRegisteredRules() {
+		asst.NotEqual(tokenType, rule.TokenType, "test Unregister failed")
+	}
+	l := NewLexer(NewDFAWithDefault())
+	asst.Equal([]token.Type{token.Identifier, token.BitNot, token.RightShift, token.Identifier}, getTokenTypes(l.Lex("a ~>> b")), "test Unregister failed")
+}
@@ -0,0 +1,212 @@
+package lexer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	DefaultPriority = 0
+	KeywordPriority = 1
+	// the number literals like 1e10 and 0x1f have higher priority than the identifiers which match the same runes
+	NumberLiteralPriority = 1
+)
+
+const (
+	// a string literal is quoted by single quotes or double quotes,
+	// the quote could be escaped by a backslash or by doubling it
+	singleQuotedStringPattern = `'([^'\\]|\\.|'')*'`
+	doubleQuotedStringPattern = `"([^"\\]|\\.|"")*"`
+	// a quoted identifier is quoted by backticks, or by double quotes if ANSI_QUOTES is enabled,
+	// there is no backslash escape in the quoted identifier, the quote could only be escaped by doubling it
+	backtickQuotedIdentifierPattern = "`([^`]|``)*`"
+	doubleQuotedIdentifierPattern   = `"([^"]|"")*"`
+	// an integer is a sequence of digits, a decimal contains a decimal point,
+	// an approximate number literal is a decimal or an integer with an exponent
+	integerPattern = `[0-9]+`
+	decimalPattern = `[0-9]+\.[0-9]*|\.[0-9]+`
+	floatPattern   = `([0-9]+(\.[0-9]*)?|\.[0-9]+)[eE][+\-]?[0-9]+`
+	// a hexadecimal literal is like 0x1f or x'1f', the digits in the quotes must be even,
+	// a bit-value literal is like 0b101 or b'101'
+	hexPattern = `0x[0-9a-fA-F]+|[xX]'([0-9a-fA-F][0-9a-fA-F])*'`
+	bitPattern = `0b[01]+|[bB]'[01]*'`
+	// the quoted hexadecimal and bit-value literals with the malformed digits are the errors,
+	// they are longer than the identifier and the string literal which match the same runes,
+	// and the valid literals have higher priority than them
+	invalidHexPattern = `[xX]'[^']*'`
+	invalidBitPattern = `[bB]'[^']*'`
+	// a single line comment starts with # or --, the -- must be followed by a white space, a control rune or the end of the input,
+	// the single line comment ends before the newline, unless the newline follows the -- directly,
+	// the pattern could not match the end of the input, so the -- at the end of the input is handled by the scanner
+	hashCommentPattern       = `#[^\n]*`
+	doubleDashCommentPattern = `--([\x{0}-\x{9}\x{b}-\x{20}\x{7f}][^\n]*|\n)`
+	// a block comment starts with /* and ends with */, it could not be nested,
+	// the executable comment like /*!80000 ... */ is also a block comment
+	blockCommentPattern = `/\*([^*]|\*+[^*/])*\*+/`
+	// a positional placeholder is ? or $ followed by the position number like $1
+	positionalPlaceholderPattern = `\?|\$[0-9]+`
+	// a named placeholder is like :name, a user variable is like @name, @'name', @"name" or @`name`,
+	// a system variable is like @@name or @@global.name, the patterns of the names are built with the character set
+	namedPlaceholderFormat = `:%s%s*`
+	userVariableFormat     = `@(%s+|%s|%s|%s)`
+	systemVariableFormat   = `@@%s%s*(\.%s%s*)?`
+)
+
+var (
+	// registeredRules are the rules which are registered at runtime, they are appended to the default rules
+	registeredRules      []*Rule
+	registeredRulesMutex sync.RWMutex
+)
+
+// Rule describes how to recognize a kind of token
+type Rule struct {
+	TokenType token.Type
+	Pattern   string
+	Priority  int
+}
+
+// NewRule returns a new *Rule, the pattern is a regular expression,
+// if the same runes match multiple rules, the token type of the rule with higher priority will be used
+func NewRule(tokenType token.Type, pattern string, priority int) *Rule {
+	return &Rule{
+		TokenType: tokenType,
+		Pattern:   pattern,
+		Priority:  priority,
+	}
+}
+
+// GetDefaultRules returns the rules of the built-in tokens, the double-quoted text is a string literal
+func GetDefaultRules(cs *CharacterSet) []*Rule {
+	return GetDefaultRulesWithANSIQuotes(cs, false)
+}
+
+// GetDefaultRulesWithANSIQuotes returns the rules of the built-in tokens,
+// if ansiQuotes is true, the double-quoted text is a quoted identifier, just like the ANSI_QUOTES sql mode of mysql,
+// otherwise, it is a string literal
+func GetDefaultRulesWithANSIQuotes(cs *CharacterSet, ansiQuotes bool) []*Rule {
+	var rules []*Rule
+
+	// keywords and multi rune operators
+	for tokenType, tokenString := range MultiRuneMap {
+		if tokenType.IsKeyword() {
+			// keywords are case-insensitive
+			rules = append(rules, NewRule(tokenType, QuoteMetaIgnoreCase(tokenString), KeywordPriority))
+			continue
+		}
+		rules = append(rules, NewRule(tokenType, QuoteMeta(tokenString), DefaultPriority))
+	}
+	// single rune operators and separators
+	for tokenType, c := range SingleRuneMap {
+		rules = append(rules, NewRule(tokenType, QuoteMeta(string(c)), DefaultPriority))
+	}
+
+	// the map iteration order is random, sort the rules so that the generated states are always the same
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].TokenType < rules[j].TokenType
+	})
+
+	alphabets := getRangesPattern(cs.GetAlphabetRanges())
+	digits := getRangesPattern(cs.GetDigitRanges())
+	alphabetsOrDigits := getRangesPattern(normalizeRanges(append(cs.GetAlphabetRanges(), cs.GetDigitRanges()...)))
+	// the name of the user variable could also contain . and $
+	userVariableRunes := getRangesPattern(normalizeRanges(append(append(cs.GetAlphabetRanges(), cs.GetDigitRanges()...),
+		NewRuneRange(DotRune, DotRune), NewRuneRange(DollarRune, DollarRune))))
+	rules = append(rules,
+		// identifier may start with digits, but must contain at least one alphabet
+		NewRule(token.Identifier, fmt.Sprintf("%s*%s%s*", digits, alphabets, alphabetsOrDigits), DefaultPriority),
+		NewRule(token.QuotedIdentifier, backtickQuotedIdentifierPattern, DefaultPriority),
+		NewRule(token.StringLiteral, singleQuotedStringPattern, DefaultPriority),
+		NewRule(token.NumberLiteral, integerPattern, DefaultPriority),
+		NewRule(token.DecimalLiteral, decimalPattern, DefaultPriority),
+		NewRule(token.FloatLiteral, floatPattern, NumberLiteralPriority),
+		NewRule(token.HexLiteral, hexPattern, NumberLiteralPriority),
+		NewRule(token.BitLiteral, bitPattern, NumberLiteralPriority),
+		NewRule(token.Error, invalidHexPattern, DefaultPriority),
+		NewRule(token.Error, invalidBitPattern, DefaultPriority),
+		NewRule(token.PositionalPlaceholder, positionalPlaceholderPattern, DefaultPriority),
+		NewRule(token.NamedPlaceholder, fmt.Sprintf(namedPlaceholderFormat, alphabets, alphabetsOrDigits), DefaultPriority),
+		NewRule(token.UserVariable, fmt.Sprintf(userVariableFormat, userVariableRunes,
+			singleQuotedStringPattern, doubleQuotedStringPattern, backtickQuotedIdentifierPattern), DefaultPriority),
+		NewRule(token.SystemVariable, fmt.Sprintf(systemVariableFormat, alphabets, alphabetsOrDigits, alphabets, alphabetsOrDigits), DefaultPriority),
+		NewRule(token.WhiteSpace, fmt.Sprintf("%s+", getClassPattern(WhiteSpaceRunes)), DefaultPriority),
+		NewRule(token.Comment, hashCommentPattern, DefaultPriority),
+		NewRule(token.Comment, doubleDashCommentPattern, DefaultPriority),
+		NewRule(token.Comment, blockCommentPattern, DefaultPriority),
+	)
+	if ansiQuotes {
+		rules = append(rules, NewRule(token.QuotedIdentifier, doubleQuotedIdentifierPattern, DefaultPriority))
+	} else {
+		rules = append(rules, NewRule(token.StringLiteral, doubleQuotedStringPattern, DefaultPriority))
+	}
+
+	return append(rules, getRegisteredRules()...)
+}
+
+// RegisterRule registers the rule of a token type, the lexers which are built with the default rules afterwards
+// recognize the token type by the rule, the token type must have been registered in the token registry,
+// the keywords and the operators which are registered with their texts are recognized without registering rules
+func RegisterRule(rule *Rule) error {
+	_, ok := token.GetTypeInfo(rule.TokenType)
+	if !ok {
+		return errors.Errorf("token type is not registered. token type: %d", rule.TokenType)
+	}
+	_, err := parseRegexp(rule.Pattern, NewCharacterSetWithDefault().GetRanges())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	registeredRulesMutex.Lock()
+	defer registeredRulesMutex.Unlock()
+
+	registeredRules = append(registeredRules, rule)
+
+	return nil
+}
+
+// UnregisterRules unregisters all the rules of the given token type which are registered by RegisterRule,
+// the lexers which have been built are not affected
+func UnregisterRules(tokenType token.Type) {
+	registeredRulesMutex.Lock()
+	defer registeredRulesMutex.Unlock()
+
+	var rules []*Rule
+	for _, rule := range registeredRules {
+		if rule.TokenType != tokenType {
+			rules = append(rules, rule)
+		}
+	}
+	registeredRules = rules
+}
+
+// getRegisteredRules returns the rules of the token types which are registered at runtime,
+// the keywords and the operators are recognized by their texts, the others are recognized by the registered rules
+func getRegisteredRules() []*Rule {
+	var rules []*Rule
+
+	for _, info := range token.GetRegisteredTypeInfos() {
+		if info.Text == constant.EmptyString {
+			continue
+		}
+		if info.Category.Has(token.KeywordCategory) {
+			rules = append(rules, NewRule(info.Type, QuoteMetaIgnoreCase(strings.ToLower(info.Text)), KeywordPriority))
+			continue
+		}
+		rules = append(rules, NewRule(info.Type, QuoteMeta(info.Text), DefaultPriority))
+	}
+
+	registeredRulesMutex.RLock()
+	defer registeredRulesMutex.RUnlock()
+
+	return append(rules, registeredRules...)
+}
+
+// String returns the string representation of the rule
+func (r *Rule) String() string {
+	return fmt.Sprintf("{tokenType: %s, pattern: %s, priority: %d}", r.TokenType.String(), r.Pattern, r.Priority)
+}
@@ -0,0 +1,124 @@
+package lexer
+
+import (
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRule_All(t *testing.T) {
+	TestRule_Match(t)
+	TestRule_Priority(t)
+	TestRule_InvalidPattern(t)
+	TestRule_QuoteMeta(t)
+	TestRule_Unicode(t)
+}
+
+func TestRule_Match(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	rules := []*Rule{
+		NewRule(token.Identifier, "[a-z_][a-z0-9_]*", DefaultPriority),
+		NewRule(token.NumberLiteral, "[0-9]+(x[0-9a-f]+)?", DefaultPriority),
+		NewRule(token.StringLiteral, "'[^']*'", DefaultPriority),
+		NewRule(token.GE, ">=", DefaultPriority),
+		NewRule(token.GT, ">", DefaultPriority),
+		NewRule(token.WhiteSpace, `[ \t\r\n]+`, DefaultPriority),
+	}
+	nfa, err := NewNFAWithRules(cs, rules)
+	asst.Nil(err, "test Match() failed")
+	dfa := NewDFAWithNFA(nfa)
+
+	cases := []struct {
+		input     string
+		tokenType token.Type
+	}{
+		{"abc", token.Identifier},
+		{"_a1", token.Identifier},
+		{"1abc", token.Error},
+		{"123", token.NumberLiteral},
+		{"0x1f", token.NumberLiteral},
+		{"0x", token.Error},
+		{"'ab_1'", token.StringLiteral},
+		{"'abc", token.Error},
+		{">=", token.GE},
+		{">", token.GT},
+		{" \t\n", token.WhiteSpace},
+		{"", token.Error},
+	}
+
+	for _, c := range cases {
+		asst.Equal(c.tokenType, nfa.Match([]rune(c.input)).Type, "test Match() failed. input: %s", c.input)
+		asst.Equal(c.tokenType, dfa.Match([]rune(c.input)).Type, "test Match() failed. input: %s", c.input)
+	}
+}
+
+func TestRule_Priority(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	rules := []*Rule{
+		NewRule(token.Identifier, "[a-z]+", DefaultPriority),
+		NewRule(token.Select, "select", KeywordPriority),
+	}
+	dfa, err := NewDFAWithRules(cs, rules)
+	asst.Nil(err, "test Priority() failed")
+
+	asst.Equal(token.Select, dfa.Match([]rune("select")).Type, "test Priority() failed")
+	asst.Equal(token.Identifier, dfa.Match([]rune("selectt")).Type, "test Priority() failed")
+	asst.Equal(token.Identifier, dfa.Match([]rune("sel")).Type, "test Priority() failed")
+}
+
+func TestRule_InvalidPattern(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	patterns := []string{"(ab", "ab)", "[ab", "*a", "a|+", `a\`, "[z-a]", "[]", `\x4e2d`, `\x{4e2d`, `\x{zz}`, `\x{110000}`}
+	for _, pattern := range patterns {
+		_, err := NewNFAWithRules(cs, []*Rule{NewRule(token.Identifier, pattern, DefaultPriority)})
+		asst.NotNil(err, "test InvalidPattern() failed. pattern: %s", pattern)
+	}
+}
+
+func TestRule_QuoteMeta(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	for _, str := range []string{"(", "*", "a|b", "[x]", `\`} {
+		nfa, err := NewNFAWithRules(cs, []*Rule{NewRule(token.Identifier, QuoteMeta(str), DefaultPriority)})
+		asst.Nil(err, "test QuoteMeta() failed. str: %s", str)
+		asst.Equal(token.Identifier, nfa.Match([]rune(str)).Type, "test QuoteMeta() failed. str: %s", str)
+	}
+}
+
+func TestRule_Unicode(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := NewCharacterSetWithDefault()
+	rules := []*Rule{
+		NewRule(token.Identifier, `[\x{4e00}-\x{9fff}]+`, DefaultPriority),
+		NewRule(token.StringLiteral, `'[^']*'`, DefaultPriority),
+		NewRule(token.Comment, `#.*`, DefaultPriority),
+	}
+	nfa, err := NewNFAWithRules(cs, rules)
+	asst.Nil(err, "test Unicode failed")
+	dfa := NewDFAWithNFA(nfa)
+
+	cases := []struct {
+		input     string
+		tokenType token.Type
+	}{
+		{"中文", token.Identifier},
+		{"中文é", token.Error},
+		{"'中文 é 😀'", token.StringLiteral},
+		{"# 注释 😀", token.Comment},
+	}
+	for _, c := range cases {
+		asst.Equal(c.tokenType, nfa.Match([]rune(c.input)).Type, "test Unicode failed. input: %s", c.input)
+		asst.Equal(c.tokenType, dfa.Match([]rune(c.input)).Type, "test Unicode failed. input: %s", c.input)
+	}
+	// the range of the negated class is a few transitions, not one transition per rune
+	asst.LessOrEqual(len(dfa.InitSet.GetNext('\'').Next), 8, "test Unicode failed")
+}
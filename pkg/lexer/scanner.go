@@ -0,0 +1,299 @@
+package lexer
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// scannerReadSize is the minimum size of the buffer which reads the input from the reader
+	scannerReadSize = 4096
+)
+
+// Scanner reads the input from the reader piece by piece and scans it with a raw scanner,
+// the raw tokens are converted to the tokens with the spans and the values
+type Scanner struct {
+	raw    *RawScanner
+	reader io.Reader
+	buf    []byte
+	isEOF  bool
+	// pos is the position of the byte at posOffset of the input of the raw scanner
+	pos          token.Position
+	posOffset    int
+	lossless     bool
+	skipComments bool
+	// trivia are the scanned trivia which are not attached to any token yet
+	trivia []*token.Token
+	// lookAhead is the token which is scanned while collecting the trailing trivia of the previous token
+	lookAhead *token.Token
+	// isEnd is true if the end token has been returned in lossless mode
+	isEnd bool
+	// diagnostics are the problems of the scanned input, each error token has a diagnostic
+	diagnostics []*Diagnostic
+}
+
+// NewScanner returns a new *Scanner which reads the input from the given reader,
+// it only keeps the input of the token that is being scanned in memory,
+// comments are skipped and the executable comments are executed on DefaultServerVersion by default
+func NewScanner(fa dependency.Lexer, r io.Reader) *Scanner {
+	return NewScannerWithMode(NewSQLMode(fa), r)
+}
+
+// NewScannerWithMode returns a new *Scanner which scans the input of the given reader in the given mode
+func NewScannerWithMode(mode *Mode, r io.Reader) *Scanner {
+	raw := NewRawScannerWithMode(mode)
+	raw.partial = true
+
+	return &Scanner{
+		raw:          raw,
+		reader:       r,
+		pos:          token.NewPositionWithDefault(),
+		skipComments: true,
+	}
+}
+
+// GetFiniteAutomata returns the finite automata of the scanner
+func (s *Scanner) GetFiniteAutomata() dependency.Lexer {
+	return s.raw.mode.FA
+}
+
+// AddModeRule adds a rule which pushes a mode from the mode of the scanner, e.g. NewHintRule()
+func (s *Scanner) AddModeRule(rule *ModeRule) {
+	s.raw.AddModeRule(rule)
+}
+
+// GetModes returns the names of the modes in the mode stack from the bottom to the top
+func (s *Scanner) GetModes() []string {
+	modes := make([]string, len(s.raw.stack))
+	for i, frame := range s.raw.stack {
+		modes[i] = frame.mode.Name
+	}
+
+	return modes
+}
+
+// SetSkipComments sets whether the comments are skipped
+func (s *Scanner) SetSkipComments(skipComments bool) {
+	s.skipComments = skipComments
+}
+
+// SetServerVersion sets the server version, e.g. 80000 means 8.0.0,
+// the executable comment like /*!80000 ... */ is executed only if its version is not larger than the server version
+func (s *Scanner) SetServerVersion(serverVersion int) {
+	s.raw.SetServerVersion(serverVersion)
+}
+
+// SetPosition sets the position of the first rune of the input,
+// it is useful when the input is a part of a larger text, so that the spans of the tokens are the positions in the larger text
+func (s *Scanner) SetPosition(pos token.Position) {
+	s.pos = pos
+}
+
+// GetDiagnostics returns the diagnostics of the input which has been scanned
+func (s *Scanner) GetDiagnostics() []*Diagnostic {
+	return s.diagnostics
+}
+
+// SetLossless sets whether the scanner works in lossless mode,
+// in lossless mode, the white spaces and the comments are attached to the tokens as the trivia,
+// and an end token which holds the trivia after the last token is returned at last,
+// so that concatenating the full lexemes of all the tokens reproduces the input text
+func (s *Scanner) SetLossless(lossless bool) {
+	s.lossless = lossless
+	s.raw.lossless = lossless
+}
+
+// Next scans the input and returns the next token, it returns io.EOF when there is no more token,
+// white spaces are skipped, comments are also skipped if skipComments is true,
+// in lossless mode, both of them are attached to the tokens as the trivia instead
+func (s *Scanner) Next() (*token.Token, error) {
+	if s.lossless {
+		return s.nextWithTrivia()
+	}
+
+	for {
+		t, err := s.scanWithMode()
+		if err != nil {
+			return nil, err
+		}
+		if t.Type == token.WhiteSpace || t.Type == token.Comment && s.skipComments {
+			continue
+		}
+
+		return t, nil
+	}
+}
+
+// nextWithTrivia returns the next token with the leading and trailing trivia,
+// the trivia after the token until the end of the line are the trailing trivia,
+// the others are the leading trivia of the next token
+func (s *Scanner) nextWithTrivia() (*token.Token, error) {
+	if s.isEnd {
+		return nil, io.EOF
+	}
+
+	t := s.lookAhead
+	s.lookAhead = nil
+	for t == nil {
+		st, err := s.scanWithMode()
+		if err == io.EOF {
+			// no more tokens, the remaining trivia are attached to the end token
+			t = token.NewTokenWithSpan(token.End, constant.EmptyString, token.NewSpan(s.pos, s.pos))
+			s.isEnd = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if st.Type.IsTrivia() {
+			s.trivia = append(s.trivia, st)
+			continue
+		}
+		t = st
+	}
+	t.LeadingTrivia = s.trivia
+	s.trivia = nil
+	if s.isEnd {
+		return t, nil
+	}
+
+	for {
+		st, err := s.scanWithMode()
+		if err == io.EOF {
+			return t, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !st.Type.IsTrivia() {
+			s.lookAhead = st
+			return t, nil
+		}
+
+		trailing, leading := splitTrivia(st)
+		t.TrailingTrivia = append(t.TrailingTrivia, trailing)
+		if leading != nil {
+			s.trivia = append(s.trivia, leading)
+		}
+		if strings.ContainsRune(trailing.Lexeme, NewLineRune) {
+			// the line of the token ends
+			return t, nil
+		}
+	}
+}
+
+// splitTrivia splits the white space trivia after the first new line,
+// the first part ends with the new line and belongs to the previous line,
+// the second part is nil if there is nothing after the new line or the trivia is not a white space
+func splitTrivia(t *token.Token) (*token.Token, *token.Token) {
+	i := strings.IndexRune(t.Lexeme, NewLineRune)
+	if t.Type != token.WhiteSpace || i < constant.ZeroInt || i == len(t.Lexeme)-1 {
+		return t, nil
+	}
+
+	lexeme := t.Lexeme[:i+1]
+	end := t.Span.Start.AdvanceString(lexeme)
+
+	return token.NewTokenWithSpan(t.Type, lexeme, token.NewSpan(t.Span.Start, end)),
+		token.NewTokenWithSpan(t.Type, t.Lexeme[i+1:], token.NewSpan(end, t.Span.End))
+}
+
+// scanWithMode returns the next token including the white spaces and the comments,
+// if the token pushes a mode, e.g. an executable comment which should be executed,
+// the content of it will be scanned in the pushed mode, and the mode is popped after the content is fully scanned
+func (s *Scanner) scanWithMode() (*token.Token, error) {
+	for {
+		rt, err := s.raw.scanWithMode()
+		if err == errNeedMoreInput {
+			err = s.fill()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err == io.EOF {
+			// the position of the end of the input
+			s.pos = s.advance(len(s.raw.sql))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		t := s.newToken(rt)
+		if t.Type == token.Error {
+			s.diagnostics = append(s.diagnostics, newDiagnosticWithQuote(s.raw.code, t, s.raw.quote))
+		}
+
+		return t, nil
+	}
+}
+
+// fill reads more input from the reader and feeds it to the raw scanner, the scanned input is dropped,
+// it reads at least as many bytes as the pending input, so that a long token is rescanned only a few times
+func (s *Scanner) fill() error {
+	if s.isEOF {
+		s.raw.feed(constant.EmptyString, true)
+		return nil
+	}
+
+	pending := len(s.raw.sql) - s.raw.offset
+	size := pending * 2
+	if size < scannerReadSize {
+		size = scannerReadSize
+	}
+	if cap(s.buf) < size {
+		s.buf = make([]byte, size)
+	}
+
+	minSize := pending
+	if minSize == constant.ZeroInt {
+		minSize = 1
+	}
+	n, err := io.ReadAtLeast(s.reader, s.buf[:size], minSize)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	s.isEOF = err != nil
+	// the position of the dropped input is kept
+	s.pos = s.advance(s.raw.offset)
+	s.posOffset = constant.ZeroInt
+	s.raw.feed(string(s.buf[:n]), s.isEOF)
+
+	return nil
+}
+
+// advance returns the position of the byte at the given offset of the input of the raw scanner,
+// it advances the position of the scanner to the offset as well
+func (s *Scanner) advance(offset int) token.Position {
+	s.pos = advanceBytes(s.pos, s.raw.sql[s.posOffset:offset])
+	s.posOffset = offset
+
+	return s.pos
+}
+
+// newToken returns a new token of the raw token and advances the position of the scanner,
+// the value of the token is also set, e.g. the unescaped string of a string literal,
+// the lexeme is copied, so that the token does not keep the input of the scanner in memory
+func (s *Scanner) newToken(rt RawToken) *token.Token {
+	start := s.advance(rt.Start)
+	end := s.advance(rt.End)
+
+	lexeme := rt.GetLexeme(s.raw.sql)
+	if utf8.ValidString(lexeme) {
+		var builder strings.Builder
+		builder.WriteString(lexeme)
+		lexeme = builder.String()
+	} else {
+		lexeme = string([]rune(lexeme))
+	}
+
+	t := token.NewTokenWithSpan(rt.Type, lexeme, token.NewSpan(start, end))
+	t.SetValue(GetValue(rt.Type, t.Lexeme))
+
+	return t
+}
@@ -0,0 +1,82 @@
+package lexer
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanner_All(t *testing.T) {
+	TestScanner_Next(t)
+	TestScanner_Read(t)
+}
+
+func TestScanner_Next(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "select col1, 'abc' from t01 where id <> 123 and col2 = 'é中文';"
+	expected := testNFALexer.Lex(sql)
+
+	// read one byte at a time, so that the multi-byte runes are split across reads
+	s := NewScanner(testNFA, iotest.OneByteReader(strings.NewReader(sql)))
+	for i := 0; ; i++ {
+		tk, err := s.Next()
+		if err == io.EOF {
+			asst.Equal(len(expected), i, "test Next() failed")
+			break
+		}
+		asst.Nil(err, "test Next() failed")
+		if err != nil || i >= len(expected) {
+			break
+		}
+		asst.Equal(expected[i], tk, "test Next() failed")
+		asst.Equal(tk.Lexeme, sql[tk.Span.Start.Offset:tk.Span.End.Offset], "test Next() failed")
+	}
+}
+
+func TestScanner_Read(t *testing.T) {
+	asst := assert.New(t)
+
+	sqls := []string{
+		"select /*!80000 a, */ b -- comment\nfrom t01 # comment",
+		"select a--",
+		"select 'unterminated\nfrom t01",
+		"select \x80\xff a from t01 where b = 'c\xfe'",
+		"select '" + strings.Repeat("中文", scannerReadSize) + "' from t01",
+		"select " + strings.Repeat("\x01", scannerReadSize+1) + " from t01",
+	}
+	readers := []func(r io.Reader) io.Reader{iotest.OneByteReader, iotest.HalfReader, iotest.DataErrReader}
+	for _, sql := range sqls {
+		expected := NewTokens(sql, testDFALexer.LexRaw(sql, nil))
+		for _, newReader := range readers {
+			var tokens []*token.Token
+			s := NewScanner(testDFA, newReader(strings.NewReader(sql)))
+			for {
+				tk, err := s.Next()
+				if err != nil {
+					asst.Equal(io.EOF, err, "test Next() failed. sql: %q", sql)
+					break
+				}
+				tokens = append(tokens, tk)
+			}
+			asst.Equal(expected, tokens, "test Next() failed. sql: %q", sql)
+		}
+	}
+
+	// the error of the reader is returned
+	readErr := errors.New("read error")
+	s := NewScanner(testDFA, iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("select a"))))
+	_, err := s.Next()
+	asst.Equal(iotest.ErrTimeout, err, "test Next() failed")
+	s = NewScanner(testDFA, io.MultiReader(strings.NewReader("select a"), iotest.ErrReader(readErr)))
+	tk, err := s.Next()
+	asst.Nil(err, "test Next() failed")
+	asst.Equal(token.Select, tk.Type, "test Next() failed")
+	_, err = s.Next()
+	asst.Equal(readErr, err, "test Next() failed")
+}
@@ -0,0 +1,165 @@
+package lexer
+
+import (
+	"io"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// DefaultDelimiter is the delimiter of the statements of the script by default
+	DefaultDelimiter = ";"
+	// delimiterCommand is the mysql client command which changes the delimiter, e.g. DELIMITER //
+	delimiterCommand = "delimiter"
+	// neverExecuteVersion makes the executable comments never be executed,
+	// so that the delimiters in the executable comments are ignored while splitting the script
+	neverExecuteVersion = -1
+)
+
+// ScriptStatement is a statement of the script
+type ScriptStatement struct {
+	// Text is the original text of the statement without the delimiter and the surrounding white spaces
+	Text string
+	// Span is the position of the text in the script
+	Span token.Span
+}
+
+// NewScriptStatement returns a new *ScriptStatement
+func NewScriptStatement(text string, span token.Span) *ScriptStatement {
+	return &ScriptStatement{
+		Text: text,
+		Span: span,
+	}
+}
+
+// SplitScript splits the script into the statements by the delimiter,
+// the delimiter is ";" by default, and it could be changed by the DELIMITER command of the mysql client, e.g. DELIMITER //,
+// the delimiters in the comments, the quoted strings and the quoted identifiers are ignored,
+// the statements which only contain the white spaces and the comments are skipped
+func (l *Lexer) SplitScript(script string) ([]*ScriptStatement, error) {
+	var statements []*ScriptStatement
+
+	delimiter := DefaultDelimiter
+	pos := token.NewPositionWithDefault()
+	for pos.Offset < len(script) {
+		statement, next, err := l.splitStatement(script, pos, &delimiter)
+		if err != nil {
+			return nil, err
+		}
+		if statement != nil {
+			statements = append(statements, statement)
+		}
+		pos = next
+	}
+
+	return statements, nil
+}
+
+// splitStatement scans the script from the given position until the delimiter or the end of the script,
+// it returns the statement and the position after the delimiter,
+// if the statement is a DELIMITER command, the delimiter will be changed and the returned statement is nil
+func (l *Lexer) splitStatement(script string, pos token.Position, delimiter *string) (*ScriptStatement, token.Position, error) {
+	s := NewScanner(l.GetFiniteAutomata(), strings.NewReader(script[pos.Offset:]))
+	s.SetPosition(pos)
+	s.SetSkipComments(false)
+	s.SetServerVersion(neverExecuteVersion)
+
+	var (
+		start    token.Position
+		end      token.Position
+		hasToken bool
+	)
+	for {
+		t, err := s.Next()
+		if err == io.EOF {
+			// the last statement may not end with the delimiter
+			return newScriptStatement(script, start, end, hasToken), s.pos, nil
+		}
+		if err != nil {
+			return nil, s.pos, errors.Trace(err)
+		}
+
+		if !hasToken && t.Type == token.Identifier && strings.EqualFold(t.Lexeme, delimiterCommand) {
+			// the DELIMITER command must be the beginning of a statement
+			next, err := parseDelimiterCommand(script, t, delimiter)
+
+			return nil, next, err
+		}
+
+		i := getDelimiterIndex(script, t, *delimiter)
+		if i >= constant.ZeroInt {
+			delimiterStart := t.Span.Start.AdvanceString(t.Lexeme[:i])
+			if i > constant.ZeroInt {
+				// the part of the token before the delimiter belongs to the statement
+				if !start.IsValid() {
+					start = t.Span.Start
+				}
+				end = delimiterStart
+				hasToken = true
+			}
+
+			return newScriptStatement(script, start, end, hasToken), delimiterStart.AdvanceString(*delimiter), nil
+		}
+
+		if !start.IsValid() {
+			start = t.Span.Start
+		}
+		end = t.Span.End
+		if t.Type != token.Comment {
+			hasToken = true
+		}
+	}
+}
+
+// newScriptStatement returns the statement between the given positions, it returns nil if the statement has no token
+func newScriptStatement(script string, start, end token.Position, hasToken bool) *ScriptStatement {
+	if !hasToken {
+		return nil
+	}
+
+	return NewScriptStatement(script[start.Offset:end.Offset], token.NewSpan(start, end))
+}
+
+// parseDelimiterCommand parses the DELIMITER command which begins with the given token,
+// the new delimiter is the first word after the command in the same line, the rest of the line is ignored,
+// it returns the position of the next line
+func parseDelimiterCommand(script string, t *token.Token, delimiter *string) (token.Position, error) {
+	line := script[t.Span.End.Offset:]
+	i := strings.IndexRune(line, NewLineRune)
+	if i >= constant.ZeroInt {
+		line = line[:i+1]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == constant.ZeroInt {
+		return t.Span.End, errors.Errorf("DELIMITER must be followed by a delimiter. position: %s", t.Span.Start.String())
+	}
+	*delimiter = fields[constant.ZeroInt]
+
+	return t.Span.End.AdvanceString(line), nil
+}
+
+// getDelimiterIndex returns the byte index of the delimiter in the lexeme of the token,
+// the delimiter may begin in the token and end in the following tokens, e.g. DELIMITER // and the tokens are two /,
+// it returns -1 if the delimiter does not begin in the token or the token is a comment or a quoted text
+func getDelimiterIndex(script string, t *token.Token, delimiter string) int {
+	switch t.Type {
+	case token.Comment, token.StringLiteral, token.QuotedIdentifier, token.UserVariable:
+		return -1
+	}
+
+	// only the text where the delimiter could begin in the token is searched
+	end := t.Span.Start.Offset + len(t.Lexeme) + len(delimiter) - 1
+	if end > len(script) {
+		end = len(script)
+	}
+	i := strings.Index(script[t.Span.Start.Offset:end], delimiter)
+	if i < constant.ZeroInt || i >= len(t.Lexeme) {
+		return -1
+	}
+
+	return i
+}
@@ -0,0 +1,95 @@
+package lexer
+
+import (
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScript_All(t *testing.T) {
+	TestScript_SplitScript(t)
+	TestScript_Delimiter(t)
+}
+
+func TestScript_SplitScript(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		script   string
+		expected []string
+	}{
+		{"select 1", []string{"select 1"}},
+		{"select 1; select 2;", []string{"select 1", "select 2"}},
+		{"  select 1 ;\n\n  select 2\n", []string{"select 1", "select 2"}},
+		// the delimiters in the comments, the strings and the quoted identifiers are ignored
+		{
+			"select ';' from t01; -- a;b\nselect `x;y` /* ; */ from t01 where a = \";\"",
+			[]string{"select ';' from t01", "-- a;b\nselect `x;y` /* ; */ from t01 where a = \";\""},
+		},
+		// the delimiters in the executable comments are also ignored
+		{"select /*!80000 1; */ 2; select 3", []string{"select /*!80000 1; */ 2", "select 3"}},
+		// the empty statements are skipped
+		{";; ;\n-- only a comment\n", nil},
+		{"", nil},
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA} {
+		l := NewLexer(fa)
+		for _, tc := range testCases {
+			statements, err := l.SplitScript(tc.script)
+			asst.Nil(err, "test SplitScript() failed. script: %s", tc.script)
+			asst.Equal(tc.expected, getStatementTexts(statements), "test SplitScript() failed. script: %s", tc.script)
+			for _, statement := range statements {
+				asst.Equal(statement.Text, tc.script[statement.Span.Start.Offset:statement.Span.End.Offset], "test SplitScript() failed. script: %s", tc.script)
+			}
+		}
+	}
+
+	statements, err := testDFALexer.SplitScript("select 1;\n  select 2")
+	asst.Nil(err, "test SplitScript() failed")
+	if len(statements) == 2 {
+		asst.Equal(token.NewSpan(token.NewPosition(12, 12, 2, 3), token.NewPosition(20, 20, 2, 11)), statements[1].Span, "test SplitScript() failed")
+	}
+}
+
+func TestScript_Delimiter(t *testing.T) {
+	asst := assert.New(t)
+
+	testCases := []struct {
+		script   string
+		expected []string
+	}{
+		{
+			"DELIMITER //\ncreate procedure p() begin select 1; select 2; end//\ndelimiter ;\nselect 3;",
+			[]string{"create procedure p() begin select 1; select 2; end", "select 3"},
+		},
+		{"delimiter $$\nselect 1$$ select 2 $$", []string{"select 1", "select 2"}},
+		// the rest of the line after the new delimiter is ignored
+		{"select 1;\ndelimiter ;; ignored\nselect 2;; select 3", []string{"select 1", "select 2", "select 3"}},
+		// the delimiter command only works at the beginning of a statement
+		{"select delimiter from t01; select 1", []string{"select delimiter from t01", "select 1"}},
+	}
+
+	for _, fa := range []dependency.Lexer{testNFA, testDFA} {
+		l := NewLexer(fa)
+		for _, tc := range testCases {
+			statements, err := l.SplitScript(tc.script)
+			asst.Nil(err, "test Delimiter failed. script: %s", tc.script)
+			asst.Equal(tc.expected, getStatementTexts(statements), "test Delimiter failed. script: %s", tc.script)
+		}
+	}
+
+	_, err := testDFALexer.SplitScript("select 1;\ndelimiter \nselect 2")
+	asst.NotNil(err, "test Delimiter failed")
+}
+
+func getStatementTexts(statements []*ScriptStatement) []string {
+	var texts []string
+	for _, statement := range statements {
+		texts = append(texts, statement.Text)
+	}
+
+	return texts
+}
@@ -2,16 +2,24 @@ package lexer
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/romberli/go-util/constant"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
+// SetTransition means the set transits to the next set with any rune of the range
+type SetTransition struct {
+	Range RuneRange
+	Next  *Set
+}
+
 type Set struct {
 	States    []*State
 	Index     int
-	Next      map[rune]*Set
+	Next      []*SetTransition
 	IsFinal   bool
 	TokenType token.Type
 }
@@ -20,14 +28,13 @@ type Set struct {
 func NewSet(i int) *Set {
 	return &Set{
 		Index: i,
-		Next:  make(map[rune]*Set),
 	}
 }
 
 // AddState add the given state into the set,
 //   - if a same state already exists in the set, it will be ignored
 //   - if the new state is a final state and there is already a final state exists in the set,
-//     only one state of which token type is keyword stays in the set
+//     only the state of which token type has higher priority stays in the set
 func (s *Set) AddState(state *State) {
 	if !s.Contains(state) {
 		if state.IsFinal {
@@ -41,8 +48,8 @@ func (s *Set) AddState(state *State) {
 				return
 			}
 
-			if state.TokenType.IsKeyword() {
-				// keyword token type has the top priority, it will replace the old final state
+			if state.hasPriority(final) {
+				// the token type of the new state has higher priority, it will replace the old final state
 				s.TokenType = state.TokenType
 				s.States[i] = state
 				return
@@ -55,7 +62,47 @@ func (s *Set) AddState(state *State) {
 
 // AddNext adds the next set of given rune
 func (s *Set) AddNext(c rune, ns *Set) {
-	s.Next[c] = ns
+	s.AddRangeNext(NewRuneRange(c, c), ns)
+}
+
+// AddRangeNext adds the next set of all the runes of the given range, the range must not overlap the existing ones,
+// the transitions are kept in ascending order, and the adjacent ranges which transit to the same set are merged
+func (s *Set) AddRangeNext(r RuneRange, ns *Set) {
+	i := sort.Search(len(s.Next), func(i int) bool {
+		return s.Next[i].Range.Start > r.Start
+	})
+
+	if i > constant.ZeroInt && s.Next[i-1].Next == ns && s.Next[i-1].Range.End+1 == r.Start {
+		// merge with the previous transition
+		s.Next[i-1].Range.End = r.End
+		if i < len(s.Next) && s.Next[i].Next == ns && s.Next[i].Range.Start == r.End+1 {
+			// the range fills the gap between the previous transition and the next transition
+			s.Next[i-1].Range.End = s.Next[i].Range.End
+			s.Next = append(s.Next[:i], s.Next[i+1:]...)
+		}
+		return
+	}
+	if i < len(s.Next) && s.Next[i].Next == ns && s.Next[i].Range.Start == r.End+1 {
+		// merge with the next transition
+		s.Next[i].Range.Start = r.Start
+		return
+	}
+
+	s.Next = append(s.Next, nil)
+	copy(s.Next[i+1:], s.Next[i:])
+	s.Next[i] = &SetTransition{Range: r, Next: ns}
+}
+
+// GetNext returns the next set of the given rune, it returns nil if there is no such transition
+func (s *Set) GetNext(c rune) *Set {
+	i := sort.Search(len(s.Next), func(i int) bool {
+		return s.Next[i].Range.End >= c
+	})
+	if i < len(s.Next) && s.Next[i].Range.Start <= c {
+		return s.Next[i].Next
+	}
+
+	return nil
 }
 
 // Contains returns if the given state is in the set
@@ -95,6 +142,24 @@ func (s *Set) Equal(other *Set) bool {
 	return true
 }
 
+// getKey returns the string representation of the state indexes of the set in ascending order,
+// two sets have the same key if they contain the same states
+func (s *Set) getKey() string {
+	indexes := make([]int, len(s.States))
+	for i, state := range s.States {
+		indexes[i] = state.Index
+	}
+	sort.Ints(indexes)
+
+	buf := make([]byte, constant.ZeroInt, len(indexes)*6)
+	for _, index := range indexes {
+		buf = strconv.AppendInt(buf, int64(index), 10)
+		buf = append(buf, ',')
+	}
+
+	return string(buf)
+}
+
 // String returns the string representation of the set
 func (s *Set) String() string {
 	var states string
@@ -109,8 +174,13 @@ func (s *Set) String() string {
 
 // Print prints the set and all the next sets recursively
 func (s *Set) Print() {
-	printedList := make(map[int]*Set)
+	printedList := map[int]*Set{s.Index: s}
+
+	s.print(printedList)
+}
 
+// print prints the set and the next sets which are not printed yet
+func (s *Set) print(printedList map[int]*Set) {
 	if s.IsFinal {
 		fmt.Println(fmt.Sprintf(
 			"final set found. index: %d, tokenType: %s",
@@ -118,20 +188,20 @@ func (s *Set) Print() {
 		// return
 	}
 
-	for c, ns := range s.Next {
-		printChar := c
-		if c == token.EpsilonRune {
-			printChar = EpsilonRune
-		}
+	var nextList []*Set
+	for _, t := range s.Next {
+		ns := t.Next
 		fmt.Println(fmt.Sprintf(
-			"set %s + intput '%c' -> set %s", s.String(), printChar, ns.String()))
-		printedList[ns.Index] = ns
+			"set %s + intput %s -> set %s", s.String(), t.Range.String(), ns.String()))
+		_, ok := printedList[ns.Index]
+		if !ok {
+			printedList[ns.Index] = ns
+			nextList = append(nextList, ns)
+		}
 	}
 
-	for _, ns := range printedList {
-		if s.Index != ns.Index {
-			// print recursively
-			ns.Print()
-		}
+	for _, ns := range nextList {
+		// print recursively
+		ns.print(printedList)
 	}
 }
@@ -6,48 +6,88 @@ import (
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
+// Transition means the state transits to the next state with any rune of the range
+type Transition struct {
+	Range RuneRange
+	Next  *State
+}
+
 type State struct {
-	Index     int
-	Next      map[rune][]*State
-	IsFinal   bool
-	TokenType token.Type
+	Index       int
+	Transitions []*Transition
+	Epsilons    []*State
+	IsFinal     bool
+	TokenType   token.Type
+	Priority    int
 }
 
 // NewState returns a new *State
 func NewState(i int) *State {
 	return &State{
 		Index: i,
-		Next:  make(map[rune][]*State),
 	}
 }
 
-// AddNext adds the next state of the given rune
+// AddNext adds the next state of the given rune, token.EpsilonRune means the epsilon move
 func (s *State) AddNext(c rune, ns *State) {
-	s.Next[c] = append(s.Next[c], ns)
+	if c == token.EpsilonRune {
+		s.Epsilons = append(s.Epsilons, ns)
+		return
+	}
+
+	s.AddRangeNext(NewRuneRange(c, c), ns)
+}
+
+// AddRangeNext adds the next state of all the runes of the given range
+func (s *State) AddRangeNext(r RuneRange, ns *State) {
+	s.Transitions = append(s.Transitions, &Transition{Range: r, Next: ns})
+}
+
+// GetNext returns the next states of the given rune, it does not include the epsilon moves
+func (s *State) GetNext(c rune) []*State {
+	var states []*State
+
+	for _, t := range s.Transitions {
+		if t.Range.Contains(c) {
+			states = append(states, t.Next)
+		}
+	}
+
+	return states
 }
 
-// EpsilonMove gets all the states that can transit to by epsilon move, it includes itself
+// EpsilonMove gets all the states that can transit to by epsilon move, it includes itself,
+// each state will appear only once even if there are epsilon cycles
 func (s *State) EpsilonMove() []*State {
 	states := []*State{s}
 
-	s.epsilonMove(&states)
+	s.epsilonMove(&states, map[int]bool{s.Index: true})
 
 	return states
 }
 
-// epsilonMove gets all the states that can transit to by epsilon move
-func (s *State) epsilonMove(states *[]*State) {
-	for _, state := range s.Next[token.EpsilonRune] {
+// epsilonMove gets all the states that can transit to by epsilon move, the visited states are skipped
+func (s *State) epsilonMove(states *[]*State, visited map[int]bool) {
+	for _, state := range s.Epsilons {
+		if visited[state.Index] {
+			continue
+		}
+		visited[state.Index] = true
 		*states = append(*states, state)
 		// get epsilon move states recursively
-		state.epsilonMove(states)
+		state.epsilonMove(states, visited)
 	}
 }
 
 // Print prints the state and all the next states recursively
 func (s *State) Print() {
-	printedList := make(map[int]*State)
+	printedList := map[int]*State{s.Index: s}
+
+	s.print(printedList)
+}
 
+// print prints the state and the next states which are not printed yet
+func (s *State) print(printedList map[int]*State) {
 	if s.IsFinal {
 		fmt.Println(fmt.Sprintf(
 			"final state found. index: %d, tokenType: %s",
@@ -55,21 +95,44 @@ func (s *State) Print() {
 		return
 	}
 
-	for c, nsList := range s.Next {
-		for _, ns := range nsList {
-			printChar := c
-			if c == token.EpsilonRune {
-				printChar = EpsilonRune
-			}
-			fmt.Println(fmt.Sprintf("state %d + intput '%c' -> state %d", s.Index, printChar, ns.Index))
+	var nextList []*State
+	addNext := func(input string, ns *State) {
+		fmt.Println(fmt.Sprintf("state %d + intput %s -> state %d", s.Index, input, ns.Index))
+		_, ok := printedList[ns.Index]
+		if !ok {
 			printedList[ns.Index] = ns
+			nextList = append(nextList, ns)
 		}
 	}
+	for _, ns := range s.Epsilons {
+		addNext(fmt.Sprintf("'%c'", EpsilonRune), ns)
+	}
+	for _, t := range s.Transitions {
+		addNext(t.Range.String(), t.Next)
+	}
 
-	for _, ns := range printedList {
-		if s.Index != ns.Index {
-			// print recursively
-			ns.Print()
-		}
+	for _, ns := range nextList {
+		// print recursively
+		ns.print(printedList)
+	}
+}
+
+// hasPriority returns if the final state has higher priority than the other final state,
+// the state with larger priority wins, if the priorities are the same, it depends on the token types
+func (s *State) hasPriority(other *State) bool {
+	if s.Priority != other.Priority {
+		return s.Priority > other.Priority
 	}
+
+	return hasPriority(s.TokenType, other.TokenType)
+}
+
+// hasPriority returns if the token type has higher priority than the other one when both of them match the same runes,
+// keyword has the top priority, otherwise, the token type with smaller value has higher priority
+func hasPriority(tokenType, other token.Type) bool {
+	if tokenType.IsKeyword() != other.IsKeyword() {
+		return tokenType.IsKeyword()
+	}
+
+	return tokenType < other
 }
@@ -0,0 +1,5 @@
+// Package static contains the precomputed table dfa of the default lexer,
+// it is generated by the generate lexer command with the minimized dfa, so that the dfa is not constructed at runtime
+package static
+
+//go:generate go run ../../.. generate lexer --minimize=true --ansi-quotes=false --package static --output dfa.go
@@ -0,0 +1,73 @@
+package static
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGeneratedFile = "dfa.go"
+	testSQL           = `select col1, col2 + 123 as c2, 'abc123_' from t01 tab_alias
+    where id <= 123 and col1 = 'abc' or col2 <> 456 and col3 != 789 and c4 <=> c5 || c6 >> 2;
+/* comment */ select * from t02 where col1 -> '$.a' and col2 ->> '$.b'; -- end
+`
+)
+
+var testTableDFA = lexer.NewTableDFAWithDefault()
+
+func TestStatic_All(t *testing.T) {
+	TestStatic_Generate(t)
+	TestStatic_Match(t)
+	TestStatic_Lex(t)
+}
+
+func TestStatic_Generate(t *testing.T) {
+	asst := assert.New(t)
+
+	var buffer bytes.Buffer
+	err := testTableDFA.Generate(&buffer, "static")
+	asst.Nil(err, "test Generate() failed")
+	source, err := os.ReadFile(testGeneratedFile)
+	asst.Nil(err, "test Generate() failed")
+	asst.True(bytes.Equal(buffer.Bytes(), source), "test Generate() failed. %s is out of date, please run go generate", testGeneratedFile)
+	asst.Equal(testTableDFA, DFA, "test Generate() failed")
+}
+
+func TestStatic_Match(t *testing.T) {
+	asst := assert.New(t)
+
+	inputs := getTestInputs([]rune("selctanwhrom1_' <>=!,;"), 4)
+	inputs = append(inputs, "select", "from", "where", "selectt", "'abc123_'", "123abc", "123.", "é", "中文", "<=>", "->>", "/* a */")
+	for _, input := range inputs {
+		asst.Equal(testTableDFA.Match([]rune(input)), Match([]rune(input)), "test Match() failed. input: %s", input)
+	}
+}
+
+func TestStatic_Lex(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(testTableDFA)
+	asst.Equal(l.Lex(testSQL), NewLexer().Lex(testSQL), "test Lex() failed. sql: %s", testSQL)
+}
+
+// getTestInputs returns all the strings which consist of the given runes and are not longer than the max length
+func getTestInputs(runes []rune, maxLength int) []string {
+	inputs := []string{""}
+	last := []string{""}
+	for i := 0; i < maxLength; i++ {
+		var current []string
+		for _, str := range last {
+			for _, c := range runes {
+				current = append(current, str+string(c))
+			}
+		}
+		inputs = append(inputs, current...)
+		last = current
+	}
+
+	return inputs
+}
@@ -0,0 +1,260 @@
+package lexer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	asciiSize = 128
+	// deadState means there is no transition
+	deadState = -1
+	// noClass is the class of the runes that never appear in any transition
+	noClass = 0
+)
+
+// ClassRange means all the runes of the range are in the class
+type ClassRange struct {
+	Range RuneRange
+	Class int
+}
+
+// TableDFA is a compiled representation of the DFA,
+// the runes are grouped into equivalence classes, the runes in the same class always transit to the same sets,
+// the transitions are stored in a dense table which is indexed by state * ClassCount + class
+type TableDFA struct {
+	ASCIIClasses [asciiSize]int
+	// ClassRanges contains the classes of the non-ascii runes in ascending order of the ranges
+	ClassRanges []*ClassRange
+	ClassCount  int
+	StateCount  int
+	Transitions []int
+	Finals      []token.Type
+}
+
+// NewTableDFA returns a new *TableDFA which is compiled from the given DFA, the init set is always the state 0
+func NewTableDFA(dfa *DFA) *TableDFA {
+	td := &TableDFA{
+		StateCount: len(dfa.Sets),
+	}
+
+	td.init(dfa)
+
+	return td
+}
+
+// NewTableDFAWithDefault returns a new *TableDFA which is compiled from the minimized default DFA
+func NewTableDFAWithDefault() *TableDFA {
+	dfa := NewDFAWithDefault()
+	dfa.Minimize()
+
+	return NewTableDFA(dfa)
+}
+
+// init initialize the character classes and the transition table
+func (td *TableDFA) init(dfa *DFA) {
+	// the init set must be the first state
+	states := make(map[int]int, len(dfa.Sets))
+	states[dfa.InitSet.Index] = constant.ZeroInt
+	for _, set := range dfa.Sets {
+		if set != dfa.InitSet {
+			states[set.Index] = len(states)
+		}
+	}
+
+	// the runes that transit to the same states from every set are in the same class,
+	// the runes of the same split range always transit to the same states, so the classes are computed by the ranges
+	var ranges []RuneRange
+	for _, set := range dfa.Sets {
+		for _, t := range set.Next {
+			ranges = append(ranges, t.Range)
+		}
+	}
+
+	// class 0 is reserved for the runes that never appear in any transition
+	classColumns := [][]int{nil}
+	signatures := make(map[string]int)
+	for _, r := range splitRanges(ranges) {
+		column := make([]int, len(dfa.Sets))
+		for _, set := range dfa.Sets {
+			column[states[set.Index]] = deadState
+			ns := set.GetNext(r.Start)
+			if ns != nil {
+				column[states[set.Index]] = states[ns.Index]
+			}
+		}
+
+		signature := getColumnSignature(column)
+		class, ok := signatures[signature]
+		if !ok {
+			class = len(classColumns)
+			signatures[signature] = class
+			classColumns = append(classColumns, column)
+		}
+		td.setClass(r, class)
+	}
+	td.ClassCount = len(classColumns)
+
+	td.Transitions = make([]int, td.StateCount*td.ClassCount)
+	td.Finals = make([]token.Type, td.StateCount)
+	for _, set := range dfa.Sets {
+		state := states[set.Index]
+		td.Transitions[state*td.ClassCount+noClass] = deadState
+		for class := 1; class < td.ClassCount; class++ {
+			td.Transitions[state*td.ClassCount+class] = classColumns[class][state]
+		}
+		if set.IsFinal {
+			td.Finals[state] = set.TokenType
+		}
+	}
+}
+
+// setClass sets the class of the runes of the given range, the ranges must be set in ascending order
+func (td *TableDFA) setClass(r RuneRange, class int) {
+	for ; r.Start < asciiSize && r.Start <= r.End; r.Start++ {
+		td.ASCIIClasses[r.Start] = class
+	}
+	if r.Start > r.End {
+		return
+	}
+
+	last := len(td.ClassRanges) - 1
+	if last >= constant.ZeroInt && td.ClassRanges[last].Class == class && td.ClassRanges[last].Range.End+1 == r.Start {
+		td.ClassRanges[last].Range.End = r.End
+		return
+	}
+	td.ClassRanges = append(td.ClassRanges, &ClassRange{Range: r, Class: class})
+}
+
+// GetClass returns the class of the given rune
+func (td *TableDFA) GetClass(c rune) int {
+	if c >= constant.ZeroInt && c < asciiSize {
+		return td.ASCIIClasses[c]
+	}
+
+	i := sort.Search(len(td.ClassRanges), func(i int) bool {
+		return td.ClassRanges[i].Range.End >= c
+	})
+	if i < len(td.ClassRanges) && td.ClassRanges[i].Range.Start <= c {
+		return td.ClassRanges[i].Class
+	}
+
+	return noClass
+}
+
+// GetNext returns the next state of the given state and rune, it returns deadState if there is no transition
+func (td *TableDFA) GetNext(state int, c rune) int {
+	return td.Transitions[state*td.ClassCount+td.GetClass(c)]
+}
+
+// GetTokenType returns the token type of the given state, it returns false if the state is not a final state
+func (td *TableDFA) GetTokenType(state int) (token.Type, bool) {
+	tokenType := td.Finals[state]
+	if tokenType == constant.ZeroInt {
+		return token.Error, false
+	}
+
+	return tokenType, true
+}
+
+// Print prints the character classes and the transition table
+func (td *TableDFA) Print() {
+	classRanges := make([][]string, td.ClassCount)
+	for c, class := range td.ASCIIClasses {
+		classRanges[class] = append(classRanges[class], strconv.QuoteRune(rune(c)))
+	}
+	for _, cr := range td.ClassRanges {
+		classRanges[cr.Class] = append(classRanges[cr.Class], fmt.Sprintf("%s-%s", strconv.QuoteRune(cr.Range.Start), strconv.QuoteRune(cr.Range.End)))
+	}
+	for class := 1; class < td.ClassCount; class++ {
+		fmt.Println(fmt.Sprintf("class %d: %s", class, strings.Join(classRanges[class], constant.CommaString)))
+	}
+
+	for state := 0; state < td.StateCount; state++ {
+		for class := 1; class < td.ClassCount; class++ {
+			next := td.Transitions[state*td.ClassCount+class]
+			if next != deadState {
+				fmt.Println(fmt.Sprintf("state %d + input class %d -> state %d", state, class, next))
+			}
+		}
+		tokenType, ok := td.GetTokenType(state)
+		if ok {
+			fmt.Println(fmt.Sprintf("final state found. index: %d, tokenType: %s", state, tokenType.String()))
+		}
+	}
+}
+
+// Match matches the given runes and returns proper token
+func (td *TableDFA) Match(runes []rune) *token.Token {
+	state := constant.ZeroInt
+	for _, c := range runes {
+		state = td.GetNext(state, c)
+		if state == deadState {
+			return token.NewToken(token.Error, string(runes))
+		}
+	}
+
+	tokenType, ok := td.GetTokenType(state)
+	if !ok {
+		return token.NewToken(token.Error, string(runes))
+	}
+
+	return token.NewToken(tokenType, string(runes))
+}
+
+// NewWalker returns a new walker of the TableDFA
+func (td *TableDFA) NewWalker() dependency.Walker {
+	return NewTableDFAWalker(td)
+}
+
+type TableDFAWalker struct {
+	td    *TableDFA
+	state int
+}
+
+// NewTableDFAWalker returns a new *TableDFAWalker
+func NewTableDFAWalker(td *TableDFA) *TableDFAWalker {
+	return &TableDFAWalker{
+		td: td,
+	}
+}
+
+// Reset resets the walker to the init state
+func (w *TableDFAWalker) Reset() {
+	w.state = constant.ZeroInt
+}
+
+// Step transits to the next state with the given rune
+func (w *TableDFAWalker) Step(c rune) bool {
+	next := w.td.GetNext(w.state, c)
+	if next == deadState {
+		return false
+	}
+
+	w.state = next
+
+	return true
+}
+
+// GetTokenType returns the token type of current state
+func (w *TableDFAWalker) GetTokenType() (token.Type, bool) {
+	return w.td.GetTokenType(w.state)
+}
+
+// getColumnSignature returns the string representation of the transitions of a rune
+func getColumnSignature(column []int) string {
+	var builder strings.Builder
+
+	for _, state := range column {
+		builder.WriteString(strconv.Itoa(state))
+		builder.WriteString(constant.CommaString)
+	}
+
+	return builder.String()
+}
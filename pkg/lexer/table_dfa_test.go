@@ -0,0 +1,107 @@
+package lexer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBenchmarkSQL = `select col1, col2 + 123 as c2, 'abc123_' from t01 tab_alias
+    where id <= 123 and col1 = 'abc' or col2 <> 456 and col3 != 789;
+`
+
+var (
+	testTableDFA      *TableDFA
+	testTableDFALexer *Lexer
+)
+
+func init() {
+	initTestTableDFA()
+}
+
+func initTestTableDFA() {
+	testTableDFA = NewTableDFAWithDefault()
+	testTableDFALexer = NewLexer(testTableDFA)
+}
+
+func TestTableDFA_All(t *testing.T) {
+	TestTableDFA_Print(t)
+	TestTableDFA_Match(t)
+	TestTableDFA_Lex(t)
+}
+
+func TestTableDFA_Print(t *testing.T) {
+	testTableDFA.Print()
+}
+
+func TestTableDFA_Match(t *testing.T) {
+	asst := assert.New(t)
+
+	inputs := getTestInputs([]rune("selctanwhrom1_' <>=!,;"), 4)
+	inputs = append(inputs, "select", "from", "where", "selectt", "'abc123_'", "123abc", "123.", "é", "中文")
+	for _, input := range inputs {
+		asst.Equal(testDFA.Match([]rune(input)), testTableDFA.Match([]rune(input)), "test Match() failed. input: %s", input)
+	}
+}
+
+func TestTableDFA_Lex(t *testing.T) {
+	asst := assert.New(t)
+
+	asst.Equal(testDFALexer.Lex(testBenchmarkSQL), testTableDFALexer.Lex(testBenchmarkSQL), "test Lex() failed")
+}
+
+// getBenchmarkSQL returns a large sql text which is about 1MB
+func getBenchmarkSQL() string {
+	return strings.Repeat(testBenchmarkSQL, 1024*1024/len(testBenchmarkSQL))
+}
+
+func benchmarkLex(b *testing.B, l *Lexer) {
+	sql := getBenchmarkSQL()
+	b.SetBytes(int64(len(sql)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Lex(sql)
+	}
+}
+
+func BenchmarkNFA_Lex(b *testing.B) {
+	benchmarkLex(b, testNFALexer)
+}
+
+func BenchmarkDFA_Lex(b *testing.B) {
+	benchmarkLex(b, testDFALexer)
+}
+
+func BenchmarkTableDFA_Lex(b *testing.B) {
+	benchmarkLex(b, testTableDFALexer)
+}
+
+func benchmarkMatchAll(b *testing.B, match func(runes []rune)) {
+	var inputs [][]rune
+	for _, str := range strings.Fields(testBenchmarkSQL) {
+		inputs = append(inputs, []rune(str))
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, input := range inputs {
+			match(input)
+		}
+	}
+}
+
+func BenchmarkNFA_Match(b *testing.B) {
+	benchmarkMatchAll(b, func(runes []rune) { testNFA.Match(runes) })
+}
+
+func BenchmarkDFA_Match(b *testing.B) {
+	benchmarkMatchAll(b, func(runes []rune) { testDFA.Match(runes) })
+}
+
+func BenchmarkTableDFA_Match(b *testing.B) {
+	benchmarkMatchAll(b, func(runes []rune) { testTableDFA.Match(runes) })
+}
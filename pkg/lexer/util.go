@@ -8,6 +8,11 @@ const (
 	LTRune          = '<'
 	EqualRune       = '='
 	ExclamationRune = '!'
+	// bit operator
+	PipeRune      = '|'
+	AmpersandRune = '&'
+	CaretRune     = '^'
+	TildeRune     = '~'
 	// arithmetic operator
 	PlusRune     = '+'
 	MinusRune    = '-'
@@ -20,6 +25,14 @@ const (
 	LeftParenthesisRune  = '('
 	RightParenthesisRune = ')'
 	SingleQuoteRune      = '\''
+	DoubleQuoteRune      = '"'
+	BacktickRune         = '`'
+	// placeholder and variable
+	QuestionRune = '?'
+	DollarRune   = '$'
+	ColonRune    = ':'
+	AtRune       = '@'
+	DotRune      = '.'
 	// white space
 	SpaceRune   = ' '
 	TabRune     = '\t'
@@ -27,9 +40,11 @@ const (
 	NewLineRune = '\n'
 )
 
+var WhiteSpaceRunes = []rune{SpaceRune, TabRune, ReturnRune, NewLineRune}
+
 // IsAlphabet returns if the given rune is an alphabet
 func IsAlphabet(c rune) bool {
-	return c >= 'a' && c <= 'z' || c == UnderBarRune
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == UnderBarRune
 }
 
 // IsDigit returns if the given rune is a digit
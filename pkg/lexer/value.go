@@ -0,0 +1,268 @@
+package lexer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const (
+	// the length of the opening quote and the closing quote
+	quoteLength = 2
+	nulRune     = 0
+	backspace   = '\b'
+	ctrlZRune   = 26
+
+	hexPrefix  = "0x"
+	bitPrefix  = "0b"
+	byteLength = 8
+)
+
+// GetValue returns the value of the lexeme of the given token type,
+//   - string literal: the unescaped string without quotes
+//   - quoted identifier: the identifier without quotes
+//   - integer: int64, or *big.Rat if it overflows int64 as mysql treats it as a decimal
+//   - decimal: *big.Rat, which keeps the exact value
+//   - approximate number: float64
+//   - hexadecimal and bit-value literal: []byte, which is left-padded with zero bits to full bytes
+//   - positional placeholder: int64 position of $1, or nil for ?
+//   - named placeholder and user variable: the name without the leading : or @, the quoted name is unquoted
+//   - system variable: *token.ScopedVariable
+//   - otherwise: nil, the lexeme itself is the value
+//
+// nil is also returned if the lexeme is not valid, e.g. an approximate number that is out of range
+func GetValue(tokenType token.Type, lexeme string) interface{} {
+	var (
+		value interface{}
+		err   error
+	)
+
+	switch tokenType {
+	case token.StringLiteral:
+		return UnescapeString(lexeme)
+	case token.QuotedIdentifier:
+		return UnquoteIdentifier(lexeme)
+	case token.NumberLiteral:
+		value, err = ParseInteger(lexeme)
+	case token.DecimalLiteral:
+		value, err = ParseDecimal(lexeme)
+	case token.FloatLiteral:
+		value, err = strconv.ParseFloat(lexeme, 64)
+	case token.HexLiteral:
+		value, err = ParseHex(lexeme)
+	case token.BitLiteral:
+		value, err = ParseBit(lexeme)
+	case token.PositionalPlaceholder:
+		value, err = ParsePositionalPlaceholder(lexeme)
+	case token.NamedPlaceholder:
+		return strings.TrimPrefix(lexeme, string(ColonRune))
+	case token.UserVariable:
+		return ParseUserVariable(lexeme)
+	case token.SystemVariable:
+		return ParseSystemVariable(lexeme)
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+// ParseInteger parses the integer literal, it returns *big.Rat if the integer overflows int64
+func ParseInteger(lexeme string) (interface{}, error) {
+	i, err := strconv.ParseInt(lexeme, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+	numErr, ok := err.(*strconv.NumError)
+	if ok && numErr.Err == strconv.ErrRange {
+		return ParseDecimal(lexeme)
+	}
+
+	return nil, errors.Trace(err)
+}
+
+// ParseDecimal parses the decimal literal to the exact value
+func ParseDecimal(lexeme string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(lexeme)
+	if !ok {
+		return nil, errors.Errorf("%s is not a valid decimal", lexeme)
+	}
+
+	return r, nil
+}
+
+// ParseHex parses the hexadecimal literal like 0x1f or x'1f' to bytes,
+// the odd number of digits is left-padded with a zero
+func ParseHex(lexeme string) ([]byte, error) {
+	digits, err := getLiteralDigits(lexeme, hexPrefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(digits)%2 != constant.ZeroInt {
+		digits = "0" + digits
+	}
+
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return b, nil
+}
+
+// ParseBit parses the bit-value literal like 0b101 or b'101' to bytes,
+// the bits are left-padded with zeros to full bytes
+func ParseBit(lexeme string) ([]byte, error) {
+	digits, err := getLiteralDigits(lexeme, bitPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, (len(digits)+byteLength-1)/byteLength)
+	// the first byte may have fewer bits
+	offset := len(b)*byteLength - len(digits)
+	for i, c := range digits {
+		switch c {
+		case '0':
+		case '1':
+			bit := offset + i
+			b[bit/byteLength] |= 1 << (byteLength - 1 - bit%byteLength)
+		default:
+			return nil, errors.Errorf("%s is not a valid bit-value literal", lexeme)
+		}
+	}
+
+	return b, nil
+}
+
+// getLiteralDigits returns the digits of the hexadecimal or bit-value literal,
+// the literal is either like 0x1f with the given prefix or like x'1f' with a leading letter and quotes
+func getLiteralDigits(lexeme, prefix string) (string, error) {
+	if strings.HasPrefix(lexeme, prefix) {
+		return lexeme[len(prefix):], nil
+	}
+
+	if len(lexeme) < len(prefix)+1 || lexeme[1] != SingleQuoteRune || lexeme[len(lexeme)-1] != SingleQuoteRune {
+		return constant.EmptyString, errors.Errorf("%s is not a valid literal", lexeme)
+	}
+
+	return lexeme[2 : len(lexeme)-1], nil
+}
+
+// ParsePositionalPlaceholder parses the positional placeholder, it returns the position of $1 as int64,
+// ? has no explicit position, so nil is returned
+func ParsePositionalPlaceholder(lexeme string) (interface{}, error) {
+	if !strings.HasPrefix(lexeme, string(DollarRune)) {
+		return nil, nil
+	}
+
+	position, err := strconv.ParseInt(lexeme[1:], 10, 64)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return position, nil
+}
+
+// ParseUserVariable returns the name of the user variable without the leading @, the quoted name is unquoted
+func ParseUserVariable(lexeme string) string {
+	name := strings.TrimPrefix(lexeme, string(AtRune))
+	if name == constant.EmptyString {
+		return name
+	}
+
+	switch name[0] {
+	case SingleQuoteRune, DoubleQuoteRune:
+		return UnescapeString(name)
+	case BacktickRune:
+		return UnquoteIdentifier(name)
+	default:
+		return name
+	}
+}
+
+// ParseSystemVariable returns the scope and the name of the system variable like @@global.max_connections,
+// if the part before the dot is not a scope, e.g. @@validate_password.length, the whole part after @@ is the name
+func ParseSystemVariable(lexeme string) *token.ScopedVariable {
+	name := strings.TrimPrefix(lexeme, string(AtRune)+string(AtRune))
+
+	i := strings.IndexRune(name, DotRune)
+	if i >= constant.ZeroInt {
+		scope, ok := token.LookupVariableScope(name[:i])
+		if ok {
+			return token.NewScopedVariable(scope, name[i+1:])
+		}
+	}
+
+	return token.NewScopedVariable(token.DefaultScope, name)
+}
+
+// UnescapeString removes the quotes of the string literal and unescapes it as mysql does,
+//   - the doubled quote is unescaped to a single quote
+//   - \0, \b, \n, \r, \t and \Z are unescaped to the control characters
+//   - \% and \_ are kept as they are, as they are used in the pattern of like
+//   - otherwise, the backslash is removed, e.g. \' -> ', \\ -> \, \x -> x
+func UnescapeString(lexeme string) string {
+	runes := []rune(lexeme)
+	if len(runes) < quoteLength {
+		return lexeme
+	}
+
+	quote := runes[0]
+	runes = runes[1 : len(runes)-1]
+
+	var builder strings.Builder
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == quote && i+1 < len(runes) && runes[i+1] == quote:
+			builder.WriteRune(quote)
+			i++
+		case c == backslashRune && i+1 < len(runes):
+			i++
+			switch runes[i] {
+			case '0':
+				builder.WriteRune(nulRune)
+			case 'b':
+				builder.WriteRune(backspace)
+			case 'n':
+				builder.WriteRune(NewLineRune)
+			case 'r':
+				builder.WriteRune(ReturnRune)
+			case 't':
+				builder.WriteRune(TabRune)
+			case 'Z':
+				builder.WriteRune(ctrlZRune)
+			case ModRune, UnderBarRune:
+				builder.WriteRune(backslashRune)
+				builder.WriteRune(runes[i])
+			default:
+				builder.WriteRune(runes[i])
+			}
+		default:
+			builder.WriteRune(c)
+		}
+	}
+
+	return builder.String()
+}
+
+// UnquoteIdentifier removes the quotes of the quoted identifier, the doubled quote is unescaped to a single quote
+func UnquoteIdentifier(lexeme string) string {
+	runes := []rune(lexeme)
+	if len(runes) < quoteLength {
+		return lexeme
+	}
+
+	quote := string(runes[0])
+
+	return strings.ReplaceAll(string(runes[1:len(runes)-1]), quote+quote, quote)
+}
@@ -0,0 +1,86 @@
+package lexer
+
+import (
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+type NFAWalker struct {
+	nfa    *NFA
+	states []*State
+}
+
+// NewNFAWalker returns a new *NFAWalker, it simulates the nfa with a set of states
+func NewNFAWalker(nfa *NFA) *NFAWalker {
+	w := &NFAWalker{
+		nfa: nfa,
+	}
+	w.Reset()
+
+	return w
+}
+
+// Reset resets the walker to the init state
+func (w *NFAWalker) Reset() {
+	w.states = w.nfa.getClosure(w.nfa.InitState)
+}
+
+// Step transits to the next states with the given rune
+func (w *NFAWalker) Step(c rune) bool {
+	next := w.nfa.step(w.states, c)
+	if len(next) == 0 {
+		return false
+	}
+
+	w.states = next
+
+	return true
+}
+
+// GetTokenType returns the token type of the final state which has the top priority in current states
+func (w *NFAWalker) GetTokenType() (token.Type, bool) {
+	final := getFinalState(w.states)
+	if final == nil {
+		return token.Error, false
+	}
+
+	return final.TokenType, true
+}
+
+type DFAWalker struct {
+	dfa *DFA
+	set *Set
+}
+
+// NewDFAWalker returns a new *DFAWalker
+func NewDFAWalker(dfa *DFA) *DFAWalker {
+	return &DFAWalker{
+		dfa: dfa,
+		set: dfa.InitSet,
+	}
+}
+
+// Reset resets the walker to the init set
+func (w *DFAWalker) Reset() {
+	w.set = w.dfa.InitSet
+}
+
+// Step transits to the next set with the given rune
+func (w *DFAWalker) Step(c rune) bool {
+	next := w.set.GetNext(c)
+	if next == nil {
+		return false
+	}
+
+	w.set = next
+
+	return true
+}
+
+// GetTokenType returns the token type of current set
+func (w *DFAWalker) GetTokenType() (token.Type, bool) {
+	if !w.set.IsFinal {
+		return token.Error, false
+	}
+
+	return w.set.TokenType, true
+}
@@ -39,6 +39,14 @@ const (
 	ErrNotValidLexFiniteAutomata         = 400032
 	ErrNotValidParseLexerFiniteAutomata  = 400033
 	ErrNotValidParseParserFiniteAutomata = 400034
+	ErrNotValidServerVersion             = 400035
+	ErrNotValidGenerateLexerPackage      = 400036
+	ErrEmptyGenerateLexerOutput          = 400037
+	ErrGenerateLexer                     = 400038
+	ErrNotValidGraphAutomaton            = 400039
+	ErrNotValidGraphTokenType            = 400040
+	ErrWriteGraph                        = 400041
+	ErrNotValidMaxStateCount             = 400042
 )
 
 func initErrorMessage() {
@@ -73,7 +81,15 @@ func initErrorMessage() {
 	Messages[ErrGetPidFromPidFile] = config.NewErrMessage(DefaultMessageHeader, ErrGetPidFromPidFile, "get pid from pid file failed. pid file: %s.\n%s")
 	Messages[ErrSetSid] = config.NewErrMessage(DefaultMessageHeader, ErrSetSid, "set sid failed when daemonizing server")
 	Messages[ErrRemovePidFile] = config.NewErrMessage(DefaultMessageHeader, ErrRemovePidFile, "remove pid file failed. pid file: %s.\n%s")
-	Messages[ErrNotValidLexFiniteAutomata] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidLexFiniteAutomata, "lex finite automata must be one of [nfa, dfa], %s is not valid")
-	Messages[ErrNotValidParseLexerFiniteAutomata] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidParseLexerFiniteAutomata, "parse lexer finite automata must be one of [nfa, dfa], %s is not valid")
+	Messages[ErrNotValidLexFiniteAutomata] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidLexFiniteAutomata, "lex finite automata must be one of [nfa, dfa, lazy-dfa], %s is not valid")
+	Messages[ErrNotValidParseLexerFiniteAutomata] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidParseLexerFiniteAutomata, "parse lexer finite automata must be one of [nfa, dfa, lazy-dfa], %s is not valid")
 	Messages[ErrNotValidParseParserFiniteAutomata] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidParseParserFiniteAutomata, "parse parser finite automata must be one of [nfa, ll], %s is not valid")
+	Messages[ErrNotValidServerVersion] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidServerVersion, "server version must not be smaller than 0, %d is not valid")
+	Messages[ErrNotValidGenerateLexerPackage] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidGenerateLexerPackage, "generate lexer package must be a valid go identifier, %s is not valid")
+	Messages[ErrEmptyGenerateLexerOutput] = config.NewErrMessage(DefaultMessageHeader, ErrEmptyGenerateLexerOutput, "generate lexer output must not be empty")
+	Messages[ErrNotValidGraphAutomaton] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidGraphAutomaton, "graph automaton must be one of [nfa, dfa, parser-nfa], %s is not valid")
+	Messages[ErrNotValidGraphTokenType] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidGraphTokenType, "graph token type must be the name of a token type, e.g. identifier, %s is not valid")
+	Messages[ErrWriteGraph] = config.NewErrMessage(DefaultMessageHeader, ErrWriteGraph, "write graph failed. output: %s.\n%s")
+	Messages[ErrNotValidMaxStateCount] = config.NewErrMessage(DefaultMessageHeader, ErrNotValidMaxStateCount, "max state count of the lazy dfa must be larger than 0, %d is not valid")
+	Messages[ErrGenerateLexer] = config.NewErrMessage(DefaultMessageHeader, ErrGenerateLexer, "generate lexer failed. output: %s.\n%s")
 }
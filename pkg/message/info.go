@@ -10,6 +10,9 @@ const (
 	InfoServerStop       = 200002
 	InfoServerIsRunning  = 200003
 	InfoServerNotRunning = 200004
+	// lexer
+	InfoDFAMinimized   = 200005
+	InfoLexerGenerated = 200006
 )
 
 func initInfoMessage() {
@@ -18,4 +21,7 @@ func initInfoMessage() {
 	Messages[InfoServerStop] = config.NewErrMessage(DefaultMessageHeader, InfoServerStop, "das stopped successfully. pid: %d, pid file: %s")
 	Messages[InfoServerIsRunning] = config.NewErrMessage(DefaultMessageHeader, InfoServerIsRunning, "das is running. pid: %d")
 	Messages[InfoServerNotRunning] = config.NewErrMessage(DefaultMessageHeader, InfoServerNotRunning, "das is not running. pid: %d")
+	// lexer
+	Messages[InfoDFAMinimized] = config.NewErrMessage(DefaultMessageHeader, InfoDFAMinimized, "dfa minimized. set count before: %d, after: %d")
+	Messages[InfoLexerGenerated] = config.NewErrMessage(DefaultMessageHeader, InfoLexerGenerated, "lexer generated. state count: %d, class count: %d, output: %s")
 }
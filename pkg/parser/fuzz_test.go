@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/romberli/go-util/constant"
+	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// fuzzMaxTokenCount limits the token count of the fuzzing input, as the nfa parser matches by backtracking
+	fuzzMaxTokenCount = 64
+)
+
+// FuzzParser_Match checks the nfa parser and the ll(1) parser return the same ast with the same tokens,
+// or both of them fail, the seed corpus is in testdata/fuzz/FuzzParser_Match
+func FuzzParser_Match(f *testing.F) {
+	for _, seed := range []string{
+		"select a from t01",
+		"select a as b, 'c', d - e + 1 from t01 where f >= 1 and g = 'h' or i <> ?;",
+		"select a from",
+		"select a, from t01",
+	} {
+		f.Add(seed)
+	}
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+
+	f.Fuzz(func(t *testing.T, sql string) {
+		tokens := l.Lex(sql)
+		if len(tokens) > fuzzMaxTokenCount {
+			t.Skip()
+		}
+
+		asst := assert.New(t)
+
+		// the parsers append the end token to the given slice, the capacity is limited so that the slice is copied
+		llNode, llErr := NewLLOne(tokens[:len(tokens):len(tokens)]).Match()
+		nfaNode, nfaErr := NewNFA(tokens[:len(tokens):len(tokens)]).Match()
+		asst.Equal(llErr == nil, nfaErr == nil, "test Match() failed. sql: %q, ll error: %v, nfa error: %v", sql, llErr, nfaErr)
+		if llErr == nil && nfaErr == nil {
+			asst.Equal(getTreeString(llNode), getTreeString(nfaNode), "test Match() failed. sql: %q", sql)
+		}
+	})
+}
+
+// TestFuzz_LiteralOperator checks a finding of FuzzParser_Match,
+// the ll(1) parser failed when a literal was followed by an expression operator and a column name, e.g. 1 + a
+func TestFuzz_LiteralOperator(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+	for _, sql := range []string{
+		"select 1 + a from t01",
+		"select a + 1 - 'b' from t01",
+		"select a, b as c, 1 + d - e from t01 t where f >= 1 and g = 'h' + ? or i <> @@global.j;",
+		"select a from t01 where 1 + b = c - 2",
+	} {
+		tokens := l.Lex(sql)
+		llNode, llErr := NewLLOne(tokens[:len(tokens):len(tokens)]).Match()
+		asst.Nil(llErr, "test LiteralOperator failed. sql: %s", sql)
+		nfaNode, nfaErr := NewNFA(tokens[:len(tokens):len(tokens)]).Match()
+		asst.Nil(nfaErr, "test LiteralOperator failed. sql: %s", sql)
+		if llErr == nil && nfaErr == nil {
+			asst.Equal(getTreeString(llNode), getTreeString(nfaNode), "test LiteralOperator failed. sql: %s", sql)
+		}
+	}
+}
+
+// getTreeString returns the string representation of the types, the tokens and the spans of the tree,
+// the repeat times of the nodes are ignored, as they describe the grammar rather than the matched tokens
+func getTreeString(node *ast.Node) string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("%s(%s", node.Type.String(), node.GetSpan().String()))
+	if node.Token != nil {
+		builder.WriteString(fmt.Sprintf(", %s", node.Token.String()))
+	}
+	builder.WriteString(")[")
+	for i, child := range node.Children {
+		if i > 0 {
+			builder.WriteString(constant.CommaString)
+		}
+		builder.WriteString(getTreeString(child))
+	}
+	builder.WriteString("]")
+
+	return builder.String()
+}
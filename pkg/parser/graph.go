@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/graph"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+const nfaGraphName = "parser_nfa"
+
+// WriteDOT writes the states of the NFA to the writer in the DOT language of Graphviz,
+// the states are labeled with the ast types of their nodes and the edges are labeled with the token types,
+// if the token types are specified, only the transitions of the given token types, the epsilon and the end are followed
+func (nfa *NFA) WriteDOT(w io.Writer, tokenTypes ...token.Type) error {
+	g := graph.NewGraph(nfaGraphName, nfa.InitState.Index)
+
+	visited := map[int]bool{nfa.InitState.Index: true}
+	stack := []*State{nfa.InitState}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		isFinal := s.Node != nil && s.Node.Type == ast.End
+		g.AddNode(graph.NewNode(s.Index, getNodeLabel(s), isFinal))
+		for _, t := range getSortedTokenTypes(s.Next) {
+			if len(tokenTypes) > 0 && t != token.Epsilon && t != token.End && !token.TypeExists(tokenTypes, t) {
+				continue
+			}
+			for _, ns := range s.Next[t] {
+				g.AddEdge(s.Index, ns.Index, t.String())
+				if !visited[ns.Index] {
+					visited[ns.Index] = true
+					stack = append(stack, ns)
+				}
+			}
+		}
+	}
+
+	return g.WriteDOT(w)
+}
+
+// getNodeLabel returns the label of the state, the state which has a node is labeled with the ast type
+func getNodeLabel(s *State) string {
+	if s.Node != nil {
+		return fmt.Sprintf("%d: %s", s.Index, s.Node.Type.String())
+	}
+
+	return strconv.Itoa(s.Index)
+}
+
+// getSortedTokenTypes returns the token types of the transitions in ascending order,
+// so that the output is always the same
+func getSortedTokenTypes(next map[token.Type][]*State) []token.Type {
+	tokenTypes := make([]token.Type, 0, len(next))
+	for t := range next {
+		tokenTypes = append(tokenTypes, t)
+	}
+	sort.Slice(tokenTypes, func(i, j int) bool {
+		return tokenTypes[i] < tokenTypes[j]
+	})
+
+	return tokenTypes
+}
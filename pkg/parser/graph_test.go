@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_All(t *testing.T) {
+	TestGraph_NFA(t)
+	TestGraph_Filter(t)
+}
+
+func TestGraph_NFA(t *testing.T) {
+	asst := assert.New(t)
+
+	var buffer bytes.Buffer
+	err := testNFA.WriteDOT(&buffer)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot := buffer.String()
+	asst.Contains(dot, `digraph "parser_nfa" {`, "test WriteDOT() failed")
+	asst.Contains(dot, ": SelectStatement\"", "test WriteDOT() failed")
+	asst.Contains(dot, `[label="selectKeyword"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `[label="ε"]`, "test WriteDOT() failed")
+	asst.Contains(dot, `: end", shape=doublecircle];`, "test WriteDOT() failed")
+
+	// the output must be the same every time
+	var other bytes.Buffer
+	err = testNFA.WriteDOT(&other)
+	asst.Nil(err, "test WriteDOT() failed")
+	asst.Equal(dot, other.String(), "test WriteDOT() failed")
+}
+
+func TestGraph_Filter(t *testing.T) {
+	asst := assert.New(t)
+
+	var buffer bytes.Buffer
+	err := testNFA.WriteDOT(&buffer, token.Select)
+	asst.Nil(err, "test WriteDOT() failed")
+	dot := buffer.String()
+	asst.Contains(dot, `[label="selectKeyword"]`, "test WriteDOT() failed")
+	asst.NotContains(dot, `[label="fromKeyword"]`, "test WriteDOT() failed")
+	asst.NotContains(dot, `: end"`, "test WriteDOT() failed")
+}
@@ -1,32 +1,54 @@
 package parser
 
 import (
+	"io"
+
 	"github.com/pingcap/errors"
 	"github.com/romberli/go-util/constant"
 	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
 type LLOne struct {
 	Tokens []*token.Token
 	Index  int
+	reader dependency.TokenReader
+	err    error
 }
 
 func NewLLOne(tokens []*token.Token) *LLOne {
 	return &LLOne{
-		Tokens: append(tokens, token.NewToken(token.End, constant.EmptyString)),
+		Tokens: appendEndToken(tokens),
 		Index:  -1,
 	}
 }
 
+// NewLLOneWithTokenReader returns a new *LLOne which reads the tokens from the given reader on demand,
+// as LL(1) only needs to look ahead one token, the tokens will not be read before they are needed
+func NewLLOneWithTokenReader(reader dependency.TokenReader) *LLOne {
+	return &LLOne{
+		Index:  -1,
+		reader: reader,
+	}
+}
+
 func (llo *LLOne) Match() (*ast.Node, error) {
+	// reset the index, so that the tokens could be matched again
+	llo.Index = -1
+
 	rootNode := llo.getNewNode(ast.Root)
 	err := llo.match(rootNode)
+	if llo.err != nil {
+		// reading tokens failed, the matching result is meaningless
+		return nil, llo.err
+	}
 	if err != nil {
 		return nil, err
 	}
+	rootNode.UpdateSpan()
 
-	if llo.Tokens[llo.Index+1].Type != token.End {
+	if llo.lookAhead().Type != token.End {
 		return nil, errors.Errorf("matching token failed: matched tokens: %v, next token: %s", llo.Tokens[:llo.Index+1], llo.Tokens[llo.Index+1])
 	}
 
@@ -34,6 +56,7 @@ func (llo *LLOne) Match() (*ast.Node, error) {
 }
 
 func (llo *LLOne) match(n *ast.Node) error {
+	llo.fill(llo.Index + 1)
 	if llo.Index == len(llo.Tokens)-1 {
 		if llo.Tokens[llo.Index].Type == token.End {
 			return nil
@@ -58,20 +81,20 @@ func (llo *LLOne) match(n *ast.Node) error {
 
 	var children []*ast.Node
 	for i, firstSet := range firstSetList {
-		if token.TypeExists(firstSet, llo.lookAhead().Type) {
+		if token.TypeExists(firstSet, getMatchType(llo.lookAhead())) {
 			// found correct path
 			children = childrenList[i]
 			for _, child := range children {
 				childFirst := child.GetFirstSet()
 			Loop:
 				// look ahead the next token to choose which path to go
-				if token.TypeExists(childFirst, llo.lookAhead().Type) {
+				if token.TypeExists(childFirst, getMatchType(llo.lookAhead())) {
 					// as there is no conflict, always can add child
 					n.AddChildren(child)
 					if child.IsTerminal() {
 						// matched a terminal node
 						t := llo.readNext()
-						if child.Type.GetTokenType() != t.Type {
+						if child.Type.GetTokenType() != getMatchType(t) {
 							return errors.Errorf("match terminal failed. child type: %s, token type: %s", child.Type.String(), t.String())
 						}
 
@@ -83,9 +106,12 @@ func (llo *LLOne) match(n *ast.Node) error {
 					if err != nil {
 						return err
 					}
+					// all the children of the child are matched, the span of the child could be determined
+					child.UpdateSpan()
 
 					if child.Max == -1 {
-						// this node may repeat for several times
+						// this node may repeat for several times, each repetition needs a new node
+						child = ast.NewNode(child.Type, child.Min, child.Max)
 						goto Loop
 					}
 
@@ -98,7 +124,7 @@ func (llo *LLOne) match(n *ast.Node) error {
 				}
 
 				// neither in the first set nor may epsilon
-				return errors.Errorf("matching token failed: node type: %s, matched tokens: %v, next token: %s", n.Type.String(), llo.Tokens[:llo.Index], llo.Tokens[llo.Index])
+				return errors.Errorf("matching token failed: node type: %s, matched tokens: %v, next token: %s", n.Type.String(), llo.Tokens[:llo.Index+1], llo.lookAhead())
 			}
 
 			// all children are matched
@@ -107,13 +133,14 @@ func (llo *LLOne) match(n *ast.Node) error {
 	}
 
 	// next token is not in any of the first set list
-	return errors.Errorf("matching token failed: node type: %s, matched tokens: %v, next token: %s", n.Type.String(), llo.Tokens[:llo.Index], llo.Tokens[llo.Index])
+	return errors.Errorf("matching token failed: node type: %s, matched tokens: %v, next token: %s", n.Type.String(), llo.Tokens[:llo.Index+1], llo.lookAhead())
 }
 
 func (llo *LLOne) lookAhead() *token.Token {
 	// if nfa.Index+1 >= len(nfa.Tokens) {
 	//     return nil
 	// }
+	llo.fill(llo.Index + 1)
 
 	return llo.Tokens[llo.Index+1]
 }
@@ -122,11 +149,39 @@ func (llo *LLOne) readNext() *token.Token {
 	// if nfa.Index+1 >= len(nfa.Tokens) {
 	//     return nil
 	// }
+	llo.fill(llo.Index + 1)
 	llo.Index++
 
 	return llo.Tokens[llo.Index]
 }
 
+// fill reads the tokens from the reader until the token of the given index is read or the end token is appended
+func (llo *LLOne) fill(i int) {
+	if llo.reader == nil {
+		// all the tokens are given when creating the parser
+		return
+	}
+
+	for len(llo.Tokens) <= i {
+		if len(llo.Tokens) > constant.ZeroInt && llo.Tokens[len(llo.Tokens)-1].Type == token.End {
+			// the reader has been exhausted
+			return
+		}
+
+		t, err := llo.reader.Next()
+		if err != nil {
+			if err != io.EOF {
+				llo.err = errors.Trace(err)
+			}
+			// no more tokens, append the end token
+			llo.Tokens = append(llo.Tokens, token.NewToken(token.End, constant.EmptyString))
+			continue
+		}
+
+		llo.Tokens = append(llo.Tokens, t)
+	}
+}
+
 // getNewState gets a new state
 func (llo *LLOne) getNewState() *State {
 	llo.Index++
@@ -1,8 +1,12 @@
 package parser
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/romberli/sql-parser-go/pkg/token"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +24,14 @@ func initTestLLParser() {
 
 func TestLLParser_All(t *testing.T) {
 	TestLLParser_Match(t)
+	TestLLParser_Span(t)
+	TestLLParser_TokenReader(t)
+	TestLLParser_CaseInsensitive(t)
+	TestLLParser_NonReservedKeyword(t)
+	TestLLParser_QuotedIdentifier(t)
+	TestLLParser_NumberLiteral(t)
+	TestLLParser_Placeholder(t)
+	TestLLParser_Lossless(t)
 }
 
 func TestLLParser_Match(t *testing.T) {
@@ -31,3 +43,196 @@ func TestLLParser_Match(t *testing.T) {
 		rootNode.PrintChildren()
 	}
 }
+
+func TestLLParser_Span(t *testing.T) {
+	asst := assert.New(t)
+
+	tokens := initTokenListWithSpan()
+	rootNode, err := NewLLOne(tokens).Match()
+	asst.Nil(err, "test Span failed")
+	if err == nil {
+		expected := token.NewSpan(tokens[0].Span.Start, tokens[len(tokens)-1].Span.End)
+		asst.Equal(expected, rootNode.GetSpan(), "test Span failed")
+		selectStatement := rootNode.Children[0]
+		asst.Equal(expected, selectStatement.GetSpan(), "test Span failed")
+		asst.Equal(tokens[1].Span, selectStatement.Children[1].GetSpan(), "test Span failed")
+	}
+}
+
+func TestLLParser_TokenReader(t *testing.T) {
+	asst := assert.New(t)
+
+	expected, err := NewLLOne(initTokenList()).Match()
+	asst.Nil(err, "test TokenReader failed")
+
+	rootNode, err := NewLLOneWithTokenReader(newTestTokenReader(initTokenList())).Match()
+	asst.Nil(err, "test TokenReader failed")
+	asst.Equal(expected, rootNode, "test TokenReader failed")
+}
+
+// getTerminalTokens returns the tokens of the terminal nodes in order
+func getTerminalTokens(node *ast.Node) []*token.Token {
+	if node.IsTerminal() {
+		return []*token.Token{node.Token}
+	}
+
+	var tokens []*token.Token
+	for _, child := range node.Children {
+		tokens = append(tokens, getTerminalTokens(child)...)
+	}
+
+	return tokens
+}
+
+func TestLLParser_CaseInsensitive(t *testing.T) {
+	asst := assert.New(t)
+
+	sql := "SELECT Col1 AS C1, 'Str1' From T01 Tab_Alias WHERE Id <= 123 AND Col2 = 'Abc'"
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+	expected := l.Lex(sql)
+
+	rootNode, err := NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test CaseInsensitive failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test CaseInsensitive failed")
+	}
+
+	nfa, err := NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test CaseInsensitive failed")
+	rootNode, err = nfa.Match()
+	asst.Nil(err, "test CaseInsensitive failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test CaseInsensitive failed")
+	}
+}
+
+func TestLLParser_NonReservedKeyword(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+
+	// status, name, user and comment are non-reserved keywords, they could be used as identifiers
+	sql := "select status, name as comment from user where status = 1"
+	rootNode, err := NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test NonReservedKeyword failed")
+	if err == nil {
+		tokens := getTerminalTokens(rootNode)
+		asst.Equal(token.StatusKeyword, tokens[1].Type, "test NonReservedKeyword failed")
+		asst.Equal(ast.Identifier, rootNode.Children[0].Children[1].Children[0].Children[0].Children[0].Children[0].Children[0].Type, "test NonReservedKeyword failed")
+	}
+	nfa, err := NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test NonReservedKeyword failed")
+	_, err = nfa.Match()
+	asst.Nil(err, "test NonReservedKeyword failed")
+
+	// order is a reserved keyword, it could not be used as an identifier
+	sql = "select col1 from order"
+	_, err = NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.NotNil(err, "test NonReservedKeyword failed")
+	nfa, err = NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test NonReservedKeyword failed")
+	_, err = nfa.Match()
+	asst.NotNil(err, "test NonReservedKeyword failed")
+}
+
+func TestLLParser_QuotedIdentifier(t *testing.T) {
+	asst := assert.New(t)
+
+	cs := lexer.NewCharacterSetWithDefault()
+	l := lexer.NewLexer(lexer.NewDFAWithNFA(lexer.NewNFAWithANSIQuotes(cs, true)))
+
+	// quoted identifiers could be used as identifiers even if they are reserved keywords
+	sql := "select `select`, \"a b\" as `order` from `t 01` where `where` = 'it''s'"
+	expected := l.Lex(sql)
+
+	rootNode, err := NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test QuotedIdentifier failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test QuotedIdentifier failed")
+	}
+
+	nfa, err := NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test QuotedIdentifier failed")
+	rootNode, err = nfa.Match()
+	asst.Nil(err, "test QuotedIdentifier failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test QuotedIdentifier failed")
+	}
+}
+
+func TestLLParser_NumberLiteral(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+
+	// all kinds of the number literals could be used where a number literal is allowed
+	sql := "select 1.5, 0x1F from t where a = 1e3 and b > b'101'"
+	expected := l.Lex(sql)
+
+	rootNode, err := NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test NumberLiteral failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test NumberLiteral failed")
+	}
+
+	nfa, err := NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test NumberLiteral failed")
+	rootNode, err = nfa.Match()
+	asst.Nil(err, "test NumberLiteral failed")
+	if err == nil {
+		asst.Equal(expected, getTerminalTokens(rootNode), "test NumberLiteral failed")
+	}
+}
+
+func TestLLParser_Placeholder(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+
+	// the placeholders and the variables could be used wherever a literal is allowed
+	sql := "select ?, @v + 1 from t where a = :a and b > $2 or c = @@global.c"
+	expected := []string{"?", ":a", "$2"}
+
+	rootNode, err := NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test Placeholder failed")
+	if err == nil {
+		asst.Equal(expected, getLexemes(rootNode.GetPlaceholders()), "test Placeholder failed")
+	}
+
+	nfa, err := NewNFAWithTokenReader(l.NewScanner(strings.NewReader(sql)))
+	asst.Nil(err, "test Placeholder failed")
+	rootNode, err = nfa.Match()
+	asst.Nil(err, "test Placeholder failed")
+	if err == nil {
+		asst.Equal(expected, getLexemes(rootNode.GetPlaceholders()), "test Placeholder failed")
+	}
+}
+
+func TestLLParser_Lossless(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+	l.SetLossless(true)
+
+	// the tokens lexed in lossless mode end with an end token, which is not appended again by the parsers
+	sql := "select a, -- first\n  b from t01 /* where */ ;\n"
+	tokens := l.Lex(sql)
+	asst.Equal(sql, token.GetFullText(tokens), "test Lossless failed")
+
+	_, err := NewLLOne(tokens).Match()
+	asst.Nil(err, "test Lossless failed")
+	_, err = NewLLOneWithTokenReader(l.NewScanner(strings.NewReader(sql))).Match()
+	asst.Nil(err, "test Lossless failed")
+	_, err = NewNFA(tokens).Match()
+	asst.Nil(err, "test Lossless failed")
+}
+
+// getLexemes returns the lexemes of the tokens of the given nodes
+func getLexemes(nodes []*ast.Node) []string {
+	lexemes := make([]string, len(nodes))
+	for i, node := range nodes {
+		lexemes[i] = node.Token.Lexeme
+	}
+
+	return lexemes
+}
@@ -1,9 +1,12 @@
 package parser
 
 import (
+	"io"
+
 	"github.com/pingcap/errors"
 	"github.com/romberli/go-util/constant"
 	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
 	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
@@ -11,12 +14,15 @@ type NFA struct {
 	Tokens    []*token.Token
 	Index     int
 	InitState *State
+	// nodes maps the node of the state to the node which is being matched,
+	// as a state may be entered several times while repeating, a new node is created each time the state is entered
+	nodes map[*ast.Node]*ast.Node
 }
 
 // NewNFA returns a new *NFA
 func NewNFA(tokens []*token.Token) *NFA {
 	nfa := &NFA{
-		Tokens: append(tokens, token.NewToken(token.End, constant.EmptyString)),
+		Tokens: appendEndToken(tokens),
 		Index:  -2,
 	}
 
@@ -25,6 +31,25 @@ func NewNFA(tokens []*token.Token) *NFA {
 	return nfa
 }
 
+// NewNFAWithTokenReader returns a new *NFA with the tokens read from the given reader,
+// as the NFA may backtrack, all the tokens will be read before matching
+func NewNFAWithTokenReader(reader dependency.TokenReader) (*NFA, error) {
+	var tokens []*token.Token
+
+	for {
+		t, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tokens = append(tokens, t)
+	}
+
+	return NewNFA(tokens), nil
+}
+
 func (nfa *NFA) init() {
 	start := nfa.getNewState()
 	rootStart, rootEnd := nfa.parseRoot()
@@ -37,12 +62,18 @@ func (nfa *NFA) init() {
 }
 
 func (nfa *NFA) Match() (*ast.Node, error) {
+	nfa.nodes = make(map[*ast.Node]*ast.Node)
+
 	err := nfa.match(nfa.InitState, constant.ZeroInt)
 	if err != nil {
 		return nil, err
 	}
 
-	return nfa.InitState.Next[token.Epsilon][constant.ZeroInt].Node, nil
+	rootNode := nfa.nodes[nfa.InitState.Next[token.Epsilon][constant.ZeroInt].Node]
+	// the children are added and removed while backtracking, so the spans are determined after all tokens are matched
+	rootNode.UpdateSpanRecursively()
+
+	return rootNode, nil
 }
 
 func (nfa *NFA) match(s *State, i int) error {
@@ -54,26 +85,20 @@ func (nfa *NFA) match(s *State, i int) error {
 		return nil
 	}
 
-	if s.Node != nil && s.Parent != nil {
-		s.Parent.AddChildren(s.Node)
-	}
+	restore := nfa.enter(s, i)
 
 	t := nfa.Tokens[i]
-	nsList := s.Next[t.Type]
+	nsList := s.Next[getMatchType(t)]
 	if nsList == nil {
 		nsList = s.Next[token.Epsilon]
 		if nsList == nil {
 			// can't transit to any other state, return error
-			if s.Node != nil && s.Parent != nil {
-				s.Parent.RemoveLastChild()
-			}
+			restore()
 			// fmt.Println(fmt.Sprintf("matching %s failed", s.Node.Type.String()))
 			return errors.Errorf("matching token failed. next token: %s", t)
 		}
 	} else {
-		// matched a token, set the token
-		nsList[constant.ZeroInt].Node.SetToken(t)
-		// increasing the index
+		// matched a token, increasing the index
 		i++
 	}
 
@@ -85,13 +110,44 @@ func (nfa *NFA) match(s *State, i int) error {
 		}
 	}
 
-	if s.Node != nil && s.Parent != nil {
-		s.Parent.RemoveLastChild()
-	}
+	restore()
 	// fmt.Println(fmt.Sprintf("matching %s failed", s.Node.Type.String()))
 	return err
 }
 
+// enter creates a new node of the state and adds it to the node which is being matched as the parent,
+// it returns a function which removes the new node and restores the former node of the state if matching fails
+func (nfa *NFA) enter(s *State, i int) func() {
+	if s.Node == nil {
+		return func() {}
+	}
+
+	node := ast.NewNode(s.Node.Type, s.Node.Min, s.Node.Max)
+	if node.IsTerminal() {
+		// a terminal state is always entered by matching the previous token
+		node.SetToken(nfa.Tokens[i-1])
+	}
+	prev, ok := nfa.nodes[s.Node]
+	nfa.nodes[s.Node] = node
+
+	var parent *ast.Node
+	if s.Parent != nil {
+		parent = nfa.nodes[s.Parent]
+		parent.AddChildren(node)
+	}
+
+	return func() {
+		if parent != nil {
+			parent.RemoveLastChild()
+		}
+		if ok {
+			nfa.nodes[s.Node] = prev
+			return
+		}
+		delete(nfa.nodes, s.Node)
+	}
+}
+
 func (nfa *NFA) Print() {
 	nfa.InitState.Print()
 }
@@ -294,14 +350,10 @@ func (nfa *NFA) parseLiteralExpression(parent *ast.Node) (*State, *State) {
 	start.SetNode(literalExpressionNode)
 	start.SetParent(parent)
 	literalStart, literalEnd := nfa.parseLiteral(literalExpressionNode)
-	otherLiteralStart, otherLiteralEnd := nfa.parseOtherLiteral(literalExpressionNode)
 	end := nfa.getNewState()
 
 	start.AddNext(token.Epsilon, literalStart)
-	literalEnd.AddNext(token.Epsilon, otherLiteralStart)
 	literalEnd.AddNext(token.Epsilon, end)
-	otherLiteralEnd.AddNext(token.Epsilon, otherLiteralStart)
-	otherLiteralEnd.AddNext(token.Epsilon, end)
 
 	return start, end
 }
@@ -323,22 +375,14 @@ func (nfa *NFA) parseLiteral(parent *ast.Node) (*State, *State) {
 	numberLiteral.AddNext(token.Epsilon, end)
 	start.AddNext(token.StringLiteral, stringLiteral)
 	stringLiteral.AddNext(token.Epsilon, end)
-
-	return start, end
-}
-
-func (nfa *NFA) parseOtherLiteral(parent *ast.Node) (*State, *State) {
-	start := nfa.getNewState()
-	otherLiteralNode := ast.NewNodeWithDefault(ast.OtherLiteral)
-	start.SetNode(otherLiteralNode)
-	start.SetParent(parent)
-	expressionOperatorStart, expressionOperatorEnd := nfa.parseExpressionOperator(otherLiteralNode)
-	literalStart, literalEnd := nfa.parseLiteral(otherLiteralNode)
-	end := nfa.getNewState()
-
-	start.AddNext(token.Epsilon, expressionOperatorStart)
-	expressionOperatorEnd.AddNext(token.Epsilon, literalStart)
-	literalEnd.AddNext(token.Epsilon, end)
+	// the placeholders and the variables could be used wherever a literal is allowed
+	for _, nodeType := range []ast.Type{ast.PositionalPlaceholder, ast.NamedPlaceholder, ast.UserVariable, ast.SystemVariable} {
+		state := nfa.getNewState()
+		state.SetNode(ast.NewNodeWithDefault(nodeType))
+		state.SetParent(literalNode)
+		start.AddNext(nodeType.GetTokenType(), state)
+		state.AddNext(token.Epsilon, end)
+	}
 
 	return start, end
 }
@@ -410,13 +454,13 @@ func (nfa *NFA) parseColumnComparison(parent *ast.Node) (*State, *State) {
 	columnComparisonNode := ast.NewNodeWithDefault(ast.ColumnComparison)
 	start.SetNode(columnComparisonNode)
 	start.SetParent(parent)
-	columnNameStart, columnNameEnd := nfa.parseColumnName(columnComparisonNode)
+	columnExpressionStart, columnExpressionEnd := nfa.parseColumnExpression(columnComparisonNode)
 	otherColumnNameStart, otherColumnNameEnd := nfa.parseOtherColumnName(columnComparisonNode)
 	end := nfa.getNewState()
 
-	start.AddNext(token.Epsilon, columnNameStart)
-	columnNameEnd.AddNext(token.Epsilon, otherColumnNameStart)
-	columnNameEnd.AddNext(token.Epsilon, end)
+	start.AddNext(token.Epsilon, columnExpressionStart)
+	columnExpressionEnd.AddNext(token.Epsilon, otherColumnNameStart)
+	columnExpressionEnd.AddNext(token.Epsilon, end)
 	otherColumnNameEnd.AddNext(token.Epsilon, end)
 
 	return start, end
@@ -428,12 +472,12 @@ func (nfa *NFA) parseOtherColumnName(parent *ast.Node) (*State, *State) {
 	start.SetNode(otherColumnNameNode)
 	start.SetParent(parent)
 	comparisonOperatorStart, comparisonOperatorEnd := nfa.parseComparisonOperator(otherColumnNameNode)
-	columnNameStart, columnNameEnd := nfa.parseColumnName(otherColumnNameNode)
+	columnExpressionStart, columnExpressionEnd := nfa.parseColumnExpression(otherColumnNameNode)
 	end := nfa.getNewState()
 
 	start.AddNext(token.Epsilon, comparisonOperatorStart)
-	comparisonOperatorEnd.AddNext(token.Epsilon, columnNameStart)
-	columnNameEnd.AddNext(token.Epsilon, end)
+	comparisonOperatorEnd.AddNext(token.Epsilon, columnExpressionStart)
+	columnExpressionEnd.AddNext(token.Epsilon, end)
 
 	return start, end
 }
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"io"
 	"testing"
 
 	"github.com/romberli/sql-parser-go/pkg/token"
@@ -42,6 +43,48 @@ func initTokenList() []*token.Token {
 	}
 }
 
+// initTokenListWithSpan returns the tokens of "select col1 from t01" with spans
+func initTokenListWithSpan() []*token.Token {
+	var tokens []*token.Token
+
+	pos := token.NewPositionWithDefault()
+	for _, t := range []*token.Token{
+		token.NewToken(token.Select, "select"),
+		token.NewToken(token.Identifier, "col1"),
+		token.NewToken(token.From, "from"),
+		token.NewToken(token.Identifier, "t01"),
+	} {
+		start := pos
+		pos = pos.AdvanceString(t.Lexeme)
+		t.SetSpan(token.NewSpan(start, pos))
+		tokens = append(tokens, t)
+		pos = pos.Advance(' ')
+	}
+
+	return tokens
+}
+
+type testTokenReader struct {
+	tokens []*token.Token
+}
+
+// newTestTokenReader returns a token reader which returns the given tokens one by one
+func newTestTokenReader(tokens []*token.Token) *testTokenReader {
+	return &testTokenReader{tokens: tokens}
+}
+
+// Next returns the next token
+func (r *testTokenReader) Next() (*token.Token, error) {
+	if len(r.tokens) == 0 {
+		return nil, io.EOF
+	}
+
+	t := r.tokens[0]
+	r.tokens = r.tokens[1:]
+
+	return t, nil
+}
+
 func initTestNFA() {
 	testNFA = NewNFA(initTokenList())
 }
@@ -49,6 +92,8 @@ func initTestNFA() {
 func TestNFA_All(t *testing.T) {
 	TestNFA_Print(t)
 	TestNFA_Match(t)
+	TestNFA_Span(t)
+	TestNFA_TokenReader(t)
 }
 
 func TestNFA_Print(t *testing.T) {
@@ -64,3 +109,31 @@ func TestNFA_Match(t *testing.T) {
 		rootNode.PrintChildren()
 	}
 }
+
+func TestNFA_Span(t *testing.T) {
+	asst := assert.New(t)
+
+	tokens := initTokenListWithSpan()
+	rootNode, err := NewNFA(tokens).Match()
+	asst.Nil(err, "test Span failed")
+	if err == nil {
+		expected := token.NewSpan(tokens[0].Span.Start, tokens[len(tokens)-1].Span.End)
+		asst.Equal(expected, rootNode.GetSpan(), "test Span failed")
+		selectStatement := rootNode.Children[0]
+		asst.Equal(expected, selectStatement.GetSpan(), "test Span failed")
+		asst.Equal(tokens[1].Span, selectStatement.Children[1].GetSpan(), "test Span failed")
+	}
+}
+
+func TestNFA_TokenReader(t *testing.T) {
+	asst := assert.New(t)
+
+	expected, err := NewNFA(initTokenList()).Match()
+	asst.Nil(err, "test TokenReader failed")
+
+	nfa, err := NewNFAWithTokenReader(newTestTokenReader(initTokenList()))
+	asst.Nil(err, "test TokenReader failed")
+	rootNode, err := nfa.Match()
+	asst.Nil(err, "test TokenReader failed")
+	asst.Equal(expected, rootNode, "test TokenReader failed")
+}
@@ -1,8 +1,10 @@
 package parser
 
 import (
+	"github.com/romberli/go-util/constant"
 	"github.com/romberli/sql-parser-go/pkg/ast"
 	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/token"
 )
 
 type Parser struct {
@@ -16,3 +18,27 @@ func NewParser(fa dependency.Parser) *Parser {
 func (p *Parser) Parse() (*ast.Node, error) {
 	return p.fa.Match()
 }
+
+// getMatchType returns the token type which is used to match the grammar,
+//   - the quoted identifiers and the non-reserved keywords could be used as identifiers, so they are matched as identifiers
+//   - all kinds of the number literals are matched as number literals
+func getMatchType(t *token.Token) token.Type {
+	if t.Type == token.QuotedIdentifier || t.Type.IsKeyword() && !t.Type.IsReserved() {
+		return token.Identifier
+	}
+	if t.Type.IsNumberLiteral() {
+		return token.NumberLiteral
+	}
+
+	return t.Type
+}
+
+// appendEndToken appends the end token to the tokens,
+// the tokens lexed in lossless mode already end with an end token which holds the trivia, so it will not be appended again
+func appendEndToken(tokens []*token.Token) []*token.Token {
+	if len(tokens) > constant.ZeroInt && tokens[len(tokens)-1].Type == token.End {
+		return tokens
+	}
+
+	return append(tokens, token.NewToken(token.End, constant.EmptyString))
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/sql-parser-go/pkg/ast"
+	"github.com/romberli/sql-parser-go/pkg/dependency"
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/romberli/sql-parser-go/pkg/token"
+)
+
+// Statement is a parsed statement of the script
+type Statement struct {
+	// Text is the original text of the statement without the delimiter and the surrounding white spaces
+	Text string
+	// Span is the position of the text in the script
+	Span token.Span
+	Node *ast.Node
+}
+
+// NewStatement returns a new *Statement
+func NewStatement(text string, span token.Span, node *ast.Node) *Statement {
+	return &Statement{
+		Text: text,
+		Span: span,
+		Node: node,
+	}
+}
+
+// NewParserFunc returns a new finite automata of the parser which reads the tokens from the given reader
+type NewParserFunc func(reader dependency.TokenReader) (dependency.Parser, error)
+
+// NewLLOneParser is a NewParserFunc which returns a new *LLOne
+func NewLLOneParser(reader dependency.TokenReader) (dependency.Parser, error) {
+	return NewLLOneWithTokenReader(reader), nil
+}
+
+// NewNFAParser is a NewParserFunc which returns a new *NFA
+func NewNFAParser(reader dependency.TokenReader) (dependency.Parser, error) {
+	nfa, err := NewNFAWithTokenReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return nfa, nil
+}
+
+// ParseScript splits the script into the statements with the lexer, see lexer.SplitScript() for the details,
+// and parses each statement with a new parser which is returned by the given function,
+// the spans of the ast nodes are the positions in the script
+func ParseScript(l *lexer.Lexer, script string, newParser NewParserFunc) ([]*Statement, error) {
+	scriptStatements, err := l.SplitScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]*Statement, len(scriptStatements))
+	for i, ss := range scriptStatements {
+		scanner := l.NewScanner(strings.NewReader(ss.Text))
+		scanner.SetPosition(ss.Span.Start)
+
+		fa, err := newParser(scanner)
+		if err != nil {
+			return nil, err
+		}
+		node, err := fa.Match()
+		if err != nil {
+			return nil, errors.Errorf("parse statement failed. span: %s, statement: %s\n%s", ss.Span.String(), ss.Text, err.Error())
+		}
+
+		statements[i] = NewStatement(ss.Text, ss.Span, node)
+	}
+
+	return statements, nil
+}
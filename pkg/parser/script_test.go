@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/romberli/sql-parser-go/pkg/lexer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScript_All(t *testing.T) {
+	TestScript_ParseScript(t)
+}
+
+func TestScript_ParseScript(t *testing.T) {
+	asst := assert.New(t)
+
+	l := lexer.NewLexer(lexer.NewDFAWithDefault())
+
+	script := "select a from t01;\n-- second\nselect b, c from t02 where b = ';';\nDELIMITER //\nselect d from t03//\n"
+	expected := []string{"select a from t01", "-- second\nselect b, c from t02 where b = ';'", "select d from t03"}
+
+	for _, newParser := range []NewParserFunc{NewLLOneParser, NewNFAParser} {
+		statements, err := ParseScript(l, script, newParser)
+		asst.Nil(err, "test ParseScript() failed")
+		asst.Equal(len(expected), len(statements), "test ParseScript() failed")
+		for i, statement := range statements {
+			asst.Equal(expected[i], statement.Text, "test ParseScript() failed")
+			// the spans of the nodes are the positions in the script
+			span := statement.Node.GetSpan()
+			asst.Equal(statement.Span.End, span.End, "test ParseScript() failed")
+			asst.Equal("select", script[span.Start.Offset:span.Start.Offset+len("select")], "test ParseScript() failed")
+		}
+
+		// the error message contains the statement which could not be parsed
+		_, err = ParseScript(l, "select a from t01; select from t02", newParser)
+		asst.NotNil(err, "test ParseScript() failed")
+		if err != nil {
+			asst.Contains(err.Error(), "select from t02", "test ParseScript() failed")
+		}
+	}
+}
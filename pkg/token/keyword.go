@@ -0,0 +1,41 @@
+package token
+
+import (
+	"strings"
+)
+
+//go:generate go run ../../tools/keyword
+
+type keyword struct {
+	tokenType Type
+	reserved  bool
+}
+
+// LookupKeyword returns the token type of the given word and if it is a reserved word,
+// the word is case-insensitive, if it is not a keyword, Identifier and false will be returned
+func LookupKeyword(word string) (Type, bool) {
+	r := defaultRegistry
+	r.mutex.RLock()
+	t, ok := r.keywords[strings.ToUpper(word)]
+	r.mutex.RUnlock()
+	if !ok {
+		return Identifier, false
+	}
+
+	return t, t.IsReserved()
+}
+
+// IsKeyword returns if the token type is a keyword
+func (t Type) IsKeyword() bool {
+	info := defaultRegistry.get(t)
+
+	return info != nil && info.Category.Has(KeywordCategory)
+}
+
+// IsReserved returns if the token type is a reserved keyword,
+// a non-reserved keyword could be used as an identifier without quoting
+func (t Type) IsReserved() bool {
+	info := defaultRegistry.get(t)
+
+	return info != nil && info.Category.Has(KeywordCategory) && info.Reserved
+}
@@ -0,0 +1,3004 @@
+// Code generated by tools/keyword from tools/keyword/keywords.txt; DO NOT EDIT.
+
+package token
+
+// the keyword token types are appended after Error, so they shift if a token type is inserted before Error
+// or a keyword is inserted before them in keywords.txt, the built-in token types which are added later are appended after keywordEnd
+const (
+	AccessibleKeyword Type = Error + 1 + iota
+	AccountKeyword
+	ActionKeyword
+	ActiveKeyword
+	AddKeyword
+	AdminKeyword
+	AfterKeyword
+	AgainstKeyword
+	AggregateKeyword
+	AlgorithmKeyword
+	AllKeyword
+	AlterKeyword
+	AlwaysKeyword
+	AnalyzeKeyword
+	AnyKeyword
+	ArrayKeyword
+	AscKeyword
+	AsciiKeyword
+	AsensitiveKeyword
+	AssignGtidsToAnonymousTransactionsKeyword
+	AtKeyword
+	AttributeKeyword
+	AuthenticationKeyword
+	AutoIncrementKeyword
+	AutoextendSizeKeyword
+	AvgKeyword
+	AvgRowLengthKeyword
+	BackupKeyword
+	BeforeKeyword
+	BeginKeyword
+	BetweenKeyword
+	BigintKeyword
+	BinaryKeyword
+	BinlogKeyword
+	BitKeyword
+	BlobKeyword
+	BlockKeyword
+	BoolKeyword
+	BooleanKeyword
+	BothKeyword
+	BtreeKeyword
+	BucketsKeyword
+	ByKeyword
+	ByteKeyword
+	CacheKeyword
+	CallKeyword
+	CascadeKeyword
+	CascadedKeyword
+	CaseKeyword
+	CatalogNameKeyword
+	ChainKeyword
+	ChallengeResponseKeyword
+	ChangeKeyword
+	ChangedKeyword
+	ChannelKeyword
+	CharKeyword
+	CharacterKeyword
+	CharsetKeyword
+	CheckKeyword
+	ChecksumKeyword
+	CipherKeyword
+	ClassOriginKeyword
+	ClientKeyword
+	CloneKeyword
+	CloseKeyword
+	CoalesceKeyword
+	CodeKeyword
+	CollateKeyword
+	CollationKeyword
+	ColumnKeyword
+	ColumnFormatKeyword
+	ColumnNameKeyword
+	ColumnsKeyword
+	CommentKeyword
+	CommitKeyword
+	CommittedKeyword
+	CompactKeyword
+	CompletionKeyword
+	ComponentKeyword
+	CompressedKeyword
+	CompressionKeyword
+	ConcurrentKeyword
+	ConditionKeyword
+	ConnectionKeyword
+	ConsistentKeyword
+	ConstraintKeyword
+	ConstraintCatalogKeyword
+	ConstraintNameKeyword
+	ConstraintSchemaKeyword
+	ContainsKeyword
+	ContextKeyword
+	ContinueKeyword
+	ConvertKeyword
+	CpuKeyword
+	CreateKeyword
+	CrossKeyword
+	CubeKeyword
+	CumeDistKeyword
+	CurrentKeyword
+	CurrentDateKeyword
+	CurrentTimeKeyword
+	CurrentTimestampKeyword
+	CurrentUserKeyword
+	CursorKeyword
+	CursorNameKeyword
+	DataKeyword
+	DatabaseKeyword
+	DatabasesKeyword
+	DatafileKeyword
+	DateKeyword
+	DatetimeKeyword
+	DayKeyword
+	DayHourKeyword
+	DayMicrosecondKeyword
+	DayMinuteKeyword
+	DaySecondKeyword
+	DeallocateKeyword
+	DecKeyword
+	DecimalKeyword
+	DeclareKeyword
+	DefaultKeyword
+	DefaultAuthKeyword
+	DefinerKeyword
+	DefinitionKeyword
+	DelayKeyWriteKeyword
+	DelayedKeyword
+	DeleteKeyword
+	DenseRankKeyword
+	DescKeyword
+	DescribeKeyword
+	DescriptionKeyword
+	DeterministicKeyword
+	DiagnosticsKeyword
+	DirectoryKeyword
+	DisableKeyword
+	DiscardKeyword
+	DiskKeyword
+	DistinctKeyword
+	DistinctrowKeyword
+	DivKeyword
+	DoKeyword
+	DoubleKeyword
+	DropKeyword
+	DualKeyword
+	DumpfileKeyword
+	DuplicateKeyword
+	DynamicKeyword
+	EachKeyword
+	ElseKeyword
+	ElseifKeyword
+	EmptyKeyword
+	EnableKeyword
+	EnclosedKeyword
+	EncryptionKeyword
+	EndKeyword
+	EndsKeyword
+	EnforcedKeyword
+	EngineKeyword
+	EngineAttributeKeyword
+	EnginesKeyword
+	EnumKeyword
+	ErrorKeyword
+	ErrorsKeyword
+	EscapeKeyword
+	EscapedKeyword
+	EventKeyword
+	EventsKeyword
+	EveryKeyword
+	ExceptKeyword
+	ExchangeKeyword
+	ExcludeKeyword
+	ExecuteKeyword
+	ExistsKeyword
+	ExitKeyword
+	ExpansionKeyword
+	ExpireKeyword
+	ExplainKeyword
+	ExportKeyword
+	ExtendedKeyword
+	ExtentSizeKeyword
+	FactorKeyword
+	FailedLoginAttemptsKeyword
+	FalseKeyword
+	FastKeyword
+	FaultsKeyword
+	FetchKeyword
+	FieldsKeyword
+	FileKeyword
+	FileBlockSizeKeyword
+	FilterKeyword
+	FinishKeyword
+	FirstKeyword
+	FirstValueKeyword
+	FixedKeyword
+	FloatKeyword
+	Float4Keyword
+	Float8Keyword
+	FlushKeyword
+	FollowingKeyword
+	FollowsKeyword
+	ForKeyword
+	ForceKeyword
+	ForeignKeyword
+	FormatKeyword
+	FoundKeyword
+	FullKeyword
+	FulltextKeyword
+	FunctionKeyword
+	GeneralKeyword
+	GeneratedKeyword
+	GeomcollectionKeyword
+	GeometryKeyword
+	GeometrycollectionKeyword
+	GetKeyword
+	GetFormatKeyword
+	GetMasterPublicKeyKeyword
+	GetSourcePublicKeyKeyword
+	GlobalKeyword
+	GrantKeyword
+	GrantsKeyword
+	GroupKeyword
+	GroupReplicationKeyword
+	GroupingKeyword
+	GroupsKeyword
+	GtidOnlyKeyword
+	HandlerKeyword
+	HashKeyword
+	HavingKeyword
+	HelpKeyword
+	HighPriorityKeyword
+	HistogramKeyword
+	HistoryKeyword
+	HostKeyword
+	HostsKeyword
+	HourKeyword
+	HourMicrosecondKeyword
+	HourMinuteKeyword
+	HourSecondKeyword
+	IdentifiedKeyword
+	IfKeyword
+	IgnoreKeyword
+	IgnoreServerIdsKeyword
+	ImportKeyword
+	InKeyword
+	InactiveKeyword
+	IndexKeyword
+	IndexesKeyword
+	InfileKeyword
+	InitialKeyword
+	InitialSizeKeyword
+	InitiateKeyword
+	InnerKeyword
+	InoutKeyword
+	InsensitiveKeyword
+	InsertKeyword
+	InsertMethodKeyword
+	InstallKeyword
+	InstanceKeyword
+	IntKeyword
+	Int1Keyword
+	Int2Keyword
+	Int3Keyword
+	Int4Keyword
+	Int8Keyword
+	IntegerKeyword
+	IntervalKeyword
+	IntoKeyword
+	InvisibleKeyword
+	InvokerKeyword
+	IoKeyword
+	IoAfterGtidsKeyword
+	IoBeforeGtidsKeyword
+	IoThreadKeyword
+	IpcKeyword
+	IsKeyword
+	IsolationKeyword
+	IssuerKeyword
+	IterateKeyword
+	JoinKeyword
+	JsonKeyword
+	JsonTableKeyword
+	JsonValueKeyword
+	KeyKeyword
+	KeyBlockSizeKeyword
+	KeyringKeyword
+	KeysKeyword
+	KillKeyword
+	LagKeyword
+	LanguageKeyword
+	LastKeyword
+	LastValueKeyword
+	LateralKeyword
+	LeadKeyword
+	LeadingKeyword
+	LeaveKeyword
+	LeavesKeyword
+	LeftKeyword
+	LessKeyword
+	LevelKeyword
+	LikeKeyword
+	LimitKeyword
+	LinearKeyword
+	LinesKeyword
+	LinestringKeyword
+	ListKeyword
+	LoadKeyword
+	LocalKeyword
+	LocaltimeKeyword
+	LocaltimestampKeyword
+	LockKeyword
+	LockedKeyword
+	LocksKeyword
+	LogfileKeyword
+	LogsKeyword
+	LongKeyword
+	LongblobKeyword
+	LongtextKeyword
+	LoopKeyword
+	LowPriorityKeyword
+	MasterKeyword
+	MasterAutoPositionKeyword
+	MasterBindKeyword
+	MasterCompressionAlgorithmsKeyword
+	MasterConnectRetryKeyword
+	MasterDelayKeyword
+	MasterHeartbeatPeriodKeyword
+	MasterHostKeyword
+	MasterLogFileKeyword
+	MasterLogPosKeyword
+	MasterPasswordKeyword
+	MasterPortKeyword
+	MasterPublicKeyPathKeyword
+	MasterRetryCountKeyword
+	MasterSslKeyword
+	MasterSslCaKeyword
+	MasterSslCapathKeyword
+	MasterSslCertKeyword
+	MasterSslCipherKeyword
+	MasterSslCrlKeyword
+	MasterSslCrlpathKeyword
+	MasterSslKeyKeyword
+	MasterSslVerifyServerCertKeyword
+	MasterTlsCiphersuitesKeyword
+	MasterTlsVersionKeyword
+	MasterUserKeyword
+	MasterZstdCompressionLevelKeyword
+	MatchKeyword
+	MaxConnectionsPerHourKeyword
+	MaxQueriesPerHourKeyword
+	MaxRowsKeyword
+	MaxSizeKeyword
+	MaxUpdatesPerHourKeyword
+	MaxUserConnectionsKeyword
+	MaxvalueKeyword
+	MediumKeyword
+	MediumblobKeyword
+	MediumintKeyword
+	MediumtextKeyword
+	MemberKeyword
+	MemoryKeyword
+	MergeKeyword
+	MessageTextKeyword
+	MicrosecondKeyword
+	MiddleintKeyword
+	MigrateKeyword
+	MinRowsKeyword
+	MinuteKeyword
+	MinuteMicrosecondKeyword
+	MinuteSecondKeyword
+	ModKeyword
+	ModeKeyword
+	ModifiesKeyword
+	ModifyKeyword
+	MonthKeyword
+	MultilinestringKeyword
+	MultipointKeyword
+	MultipolygonKeyword
+	MutexKeyword
+	MysqlErrnoKeyword
+	NameKeyword
+	NamesKeyword
+	NationalKeyword
+	NaturalKeyword
+	NcharKeyword
+	NdbKeyword
+	NdbclusterKeyword
+	NestedKeyword
+	NetworkNamespaceKeyword
+	NeverKeyword
+	NewKeyword
+	NextKeyword
+	NoKeyword
+	NoWaitKeyword
+	NoWriteToBinlogKeyword
+	NodegroupKeyword
+	NoneKeyword
+	NotKeyword
+	NowaitKeyword
+	NthValueKeyword
+	NtileKeyword
+	NullKeyword
+	NullsKeyword
+	NumberKeyword
+	NumericKeyword
+	NvarcharKeyword
+	OfKeyword
+	OffKeyword
+	OffsetKeyword
+	OjKeyword
+	OldKeyword
+	OnKeyword
+	OneKeyword
+	OnlyKeyword
+	OpenKeyword
+	OptimizeKeyword
+	OptimizerCostsKeyword
+	OptionKeyword
+	OptionalKeyword
+	OptionallyKeyword
+	OptionsKeyword
+	OrderKeyword
+	OrdinalityKeyword
+	OrganizationKeyword
+	OthersKeyword
+	OutKeyword
+	OuterKeyword
+	OutfileKeyword
+	OverKeyword
+	OwnerKeyword
+	PackKeysKeyword
+	PageKeyword
+	ParserKeyword
+	PartialKeyword
+	PartitionKeyword
+	PartitioningKeyword
+	PartitionsKeyword
+	PasswordKeyword
+	PasswordLockTimeKeyword
+	PathKeyword
+	PercentRankKeyword
+	PersistKeyword
+	PersistOnlyKeyword
+	PhaseKeyword
+	PluginKeyword
+	PluginDirKeyword
+	PluginsKeyword
+	PointKeyword
+	PolygonKeyword
+	PortKeyword
+	PrecedesKeyword
+	PrecedingKeyword
+	PrecisionKeyword
+	PrepareKeyword
+	PreserveKeyword
+	PrevKeyword
+	PrimaryKeyword
+	PrivilegeChecksUserKeyword
+	PrivilegesKeyword
+	ProcedureKeyword
+	ProcessKeyword
+	ProcesslistKeyword
+	ProfileKeyword
+	ProfilesKeyword
+	ProxyKeyword
+	PurgeKeyword
+	QuarterKeyword
+	QueryKeyword
+	QuickKeyword
+	RandomKeyword
+	RangeKeyword
+	RankKeyword
+	ReadKeyword
+	ReadOnlyKeyword
+	ReadWriteKeyword
+	ReadsKeyword
+	RealKeyword
+	RebuildKeyword
+	RecoverKeyword
+	RecursiveKeyword
+	RedoBufferSizeKeyword
+	RedundantKeyword
+	ReferenceKeyword
+	ReferencesKeyword
+	RegexpKeyword
+	RegistrationKeyword
+	RelayKeyword
+	RelayLogFileKeyword
+	RelayLogPosKeyword
+	RelayThreadKeyword
+	RelaylogKeyword
+	ReleaseKeyword
+	ReloadKeyword
+	RemoveKeyword
+	RenameKeyword
+	ReorganizeKeyword
+	RepairKeyword
+	RepeatKeyword
+	RepeatableKeyword
+	ReplaceKeyword
+	ReplicaKeyword
+	ReplicasKeyword
+	ReplicateDoDbKeyword
+	ReplicateDoTableKeyword
+	ReplicateIgnoreDbKeyword
+	ReplicateIgnoreTableKeyword
+	ReplicateRewriteDbKeyword
+	ReplicateWildDoTableKeyword
+	ReplicateWildIgnoreTableKeyword
+	ReplicationKeyword
+	RequireKeyword
+	RequireRowFormatKeyword
+	RequireTablePrimaryKeyCheckKeyword
+	ResetKeyword
+	ResignalKeyword
+	ResourceKeyword
+	RespectKeyword
+	RestartKeyword
+	RestoreKeyword
+	RestrictKeyword
+	ResumeKeyword
+	RetainKeyword
+	ReturnKeyword
+	ReturnedSqlstateKeyword
+	ReturningKeyword
+	ReturnsKeyword
+	ReuseKeyword
+	ReverseKeyword
+	RevokeKeyword
+	RightKeyword
+	RlikeKeyword
+	RoleKeyword
+	RollbackKeyword
+	RollupKeyword
+	RotateKeyword
+	RoutineKeyword
+	RowKeyword
+	RowCountKeyword
+	RowFormatKeyword
+	RowNumberKeyword
+	RowsKeyword
+	RtreeKeyword
+	SavepointKeyword
+	ScheduleKeyword
+	SchemaKeyword
+	SchemaNameKeyword
+	SchemasKeyword
+	SecondKeyword
+	SecondMicrosecondKeyword
+	SecondaryKeyword
+	SecondaryEngineKeyword
+	SecondaryEngineAttributeKeyword
+	SecondaryLoadKeyword
+	SecondaryUnloadKeyword
+	SecurityKeyword
+	SensitiveKeyword
+	SeparatorKeyword
+	SerialKeyword
+	SerializableKeyword
+	ServerKeyword
+	SessionKeyword
+	SetKeyword
+	ShareKeyword
+	ShowKeyword
+	ShutdownKeyword
+	SignalKeyword
+	SignedKeyword
+	SimpleKeyword
+	SkipKeyword
+	SlaveKeyword
+	SlowKeyword
+	SmallintKeyword
+	SnapshotKeyword
+	SocketKeyword
+	SomeKeyword
+	SonameKeyword
+	SoundsKeyword
+	SourceKeyword
+	SourceAutoPositionKeyword
+	SourceBindKeyword
+	SourceCompressionAlgorithmsKeyword
+	SourceConnectRetryKeyword
+	SourceConnectionAutoFailoverKeyword
+	SourceDelayKeyword
+	SourceHeartbeatPeriodKeyword
+	SourceHostKeyword
+	SourceLogFileKeyword
+	SourceLogPosKeyword
+	SourcePasswordKeyword
+	SourcePortKeyword
+	SourcePublicKeyPathKeyword
+	SourceRetryCountKeyword
+	SourceSslKeyword
+	SourceSslCaKeyword
+	SourceSslCapathKeyword
+	SourceSslCertKeyword
+	SourceSslCipherKeyword
+	SourceSslCrlKeyword
+	SourceSslCrlpathKeyword
+	SourceSslKeyKeyword
+	SourceSslVerifyServerCertKeyword
+	SourceTlsCiphersuitesKeyword
+	SourceTlsVersionKeyword
+	SourceUserKeyword
+	SourceZstdCompressionLevelKeyword
+	SpatialKeyword
+	SpecificKeyword
+	SqlKeyword
+	SqlAfterGtidsKeyword
+	SqlAfterMtsGapsKeyword
+	SqlBeforeGtidsKeyword
+	SqlBigResultKeyword
+	SqlBufferResultKeyword
+	SqlCalcFoundRowsKeyword
+	SqlNoCacheKeyword
+	SqlSmallResultKeyword
+	SqlThreadKeyword
+	SqlTsiDayKeyword
+	SqlTsiHourKeyword
+	SqlTsiMinuteKeyword
+	SqlTsiMonthKeyword
+	SqlTsiQuarterKeyword
+	SqlTsiSecondKeyword
+	SqlTsiWeekKeyword
+	SqlTsiYearKeyword
+	SqlexceptionKeyword
+	SqlstateKeyword
+	SqlwarningKeyword
+	SridKeyword
+	SslKeyword
+	StackedKeyword
+	StartKeyword
+	StartingKeyword
+	StartsKeyword
+	StatsAutoRecalcKeyword
+	StatsPersistentKeyword
+	StatsSamplePagesKeyword
+	StatusKeyword
+	StopKeyword
+	StorageKeyword
+	StoredKeyword
+	StraightJoinKeyword
+	StreamKeyword
+	StringKeyword
+	SubclassOriginKeyword
+	SubjectKeyword
+	SubpartitionKeyword
+	SubpartitionsKeyword
+	SuperKeyword
+	SuspendKeyword
+	SwapsKeyword
+	SwitchesKeyword
+	SystemKeyword
+	TableKeyword
+	TableChecksumKeyword
+	TableNameKeyword
+	TablesKeyword
+	TablespaceKeyword
+	TemporaryKeyword
+	TemptableKeyword
+	TerminatedKeyword
+	TextKeyword
+	ThanKeyword
+	ThenKeyword
+	ThreadPriorityKeyword
+	TiesKeyword
+	TimeKeyword
+	TimestampKeyword
+	TimestampaddKeyword
+	TimestampdiffKeyword
+	TinyblobKeyword
+	TinyintKeyword
+	TinytextKeyword
+	TlsKeyword
+	ToKeyword
+	TrailingKeyword
+	TransactionKeyword
+	TriggerKeyword
+	TriggersKeyword
+	TrueKeyword
+	TruncateKeyword
+	TypeKeyword
+	TypesKeyword
+	UnboundedKeyword
+	UncommittedKeyword
+	UndefinedKeyword
+	UndoKeyword
+	UndoBufferSizeKeyword
+	UndofileKeyword
+	UnicodeKeyword
+	UninstallKeyword
+	UnionKeyword
+	UniqueKeyword
+	UnknownKeyword
+	UnlockKeyword
+	UnregisterKeyword
+	UnsignedKeyword
+	UntilKeyword
+	UpdateKeyword
+	UpgradeKeyword
+	UsageKeyword
+	UseKeyword
+	UseFrmKeyword
+	UserKeyword
+	UserResourcesKeyword
+	UsingKeyword
+	UtcDateKeyword
+	UtcTimeKeyword
+	UtcTimestampKeyword
+	ValidationKeyword
+	ValueKeyword
+	ValuesKeyword
+	VarbinaryKeyword
+	VarcharKeyword
+	VarcharacterKeyword
+	VariablesKeyword
+	VaryingKeyword
+	VcpuKeyword
+	ViewKeyword
+	VirtualKeyword
+	VisibleKeyword
+	WaitKeyword
+	WarningsKeyword
+	WeekKeyword
+	WeightStringKeyword
+	WhenKeyword
+	WhileKeyword
+	WindowKeyword
+	WithKeyword
+	WithoutKeyword
+	WorkKeyword
+	WrapperKeyword
+	WriteKeyword
+	X509Keyword
+	XaKeyword
+	XidKeyword
+	XmlKeyword
+	XorKeyword
+	YearKeyword
+	YearMonthKeyword
+	ZerofillKeyword
+	ZoneKeyword
+	// keywordEnd is the token type after the last keyword
+	keywordEnd
+)
+
+var (
+	// KeywordList contains all the keyword token types
+	KeywordList = []Type{
+		AccessibleKeyword,
+		AccountKeyword,
+		ActionKeyword,
+		ActiveKeyword,
+		AddKeyword,
+		AdminKeyword,
+		AfterKeyword,
+		AgainstKeyword,
+		AggregateKeyword,
+		AlgorithmKeyword,
+		AllKeyword,
+		AlterKeyword,
+		AlwaysKeyword,
+		AnalyzeKeyword,
+		And,
+		AnyKeyword,
+		ArrayKeyword,
+		As,
+		AscKeyword,
+		AsciiKeyword,
+		AsensitiveKeyword,
+		AssignGtidsToAnonymousTransactionsKeyword,
+		AtKeyword,
+		AttributeKeyword,
+		AuthenticationKeyword,
+		AutoIncrementKeyword,
+		AutoextendSizeKeyword,
+		AvgKeyword,
+		AvgRowLengthKeyword,
+		BackupKeyword,
+		BeforeKeyword,
+		BeginKeyword,
+		BetweenKeyword,
+		BigintKeyword,
+		BinaryKeyword,
+		BinlogKeyword,
+		BitKeyword,
+		BlobKeyword,
+		BlockKeyword,
+		BoolKeyword,
+		BooleanKeyword,
+		BothKeyword,
+		BtreeKeyword,
+		BucketsKeyword,
+		ByKeyword,
+		ByteKeyword,
+		CacheKeyword,
+		CallKeyword,
+		CascadeKeyword,
+		CascadedKeyword,
+		CaseKeyword,
+		CatalogNameKeyword,
+		ChainKeyword,
+		ChallengeResponseKeyword,
+		ChangeKeyword,
+		ChangedKeyword,
+		ChannelKeyword,
+		CharKeyword,
+		CharacterKeyword,
+		CharsetKeyword,
+		CheckKeyword,
+		ChecksumKeyword,
+		CipherKeyword,
+		ClassOriginKeyword,
+		ClientKeyword,
+		CloneKeyword,
+		CloseKeyword,
+		CoalesceKeyword,
+		CodeKeyword,
+		CollateKeyword,
+		CollationKeyword,
+		ColumnKeyword,
+		ColumnFormatKeyword,
+		ColumnNameKeyword,
+		ColumnsKeyword,
+		CommentKeyword,
+		CommitKeyword,
+		CommittedKeyword,
+		CompactKeyword,
+		CompletionKeyword,
+		ComponentKeyword,
+		CompressedKeyword,
+		CompressionKeyword,
+		ConcurrentKeyword,
+		ConditionKeyword,
+		ConnectionKeyword,
+		ConsistentKeyword,
+		ConstraintKeyword,
+		ConstraintCatalogKeyword,
+		ConstraintNameKeyword,
+		ConstraintSchemaKeyword,
+		ContainsKeyword,
+		ContextKeyword,
+		ContinueKeyword,
+		ConvertKeyword,
+		CpuKeyword,
+		CreateKeyword,
+		CrossKeyword,
+		CubeKeyword,
+		CumeDistKeyword,
+		CurrentKeyword,
+		CurrentDateKeyword,
+		CurrentTimeKeyword,
+		CurrentTimestampKeyword,
+		CurrentUserKeyword,
+		CursorKeyword,
+		CursorNameKeyword,
+		DataKeyword,
+		DatabaseKeyword,
+		DatabasesKeyword,
+		DatafileKeyword,
+		DateKeyword,
+		DatetimeKeyword,
+		DayKeyword,
+		DayHourKeyword,
+		DayMicrosecondKeyword,
+		DayMinuteKeyword,
+		DaySecondKeyword,
+		DeallocateKeyword,
+		DecKeyword,
+		DecimalKeyword,
+		DeclareKeyword,
+		DefaultKeyword,
+		DefaultAuthKeyword,
+		DefinerKeyword,
+		DefinitionKeyword,
+		DelayKeyWriteKeyword,
+		DelayedKeyword,
+		DeleteKeyword,
+		DenseRankKeyword,
+		DescKeyword,
+		DescribeKeyword,
+		DescriptionKeyword,
+		DeterministicKeyword,
+		DiagnosticsKeyword,
+		DirectoryKeyword,
+		DisableKeyword,
+		DiscardKeyword,
+		DiskKeyword,
+		DistinctKeyword,
+		DistinctrowKeyword,
+		DivKeyword,
+		DoKeyword,
+		DoubleKeyword,
+		DropKeyword,
+		DualKeyword,
+		DumpfileKeyword,
+		DuplicateKeyword,
+		DynamicKeyword,
+		EachKeyword,
+		ElseKeyword,
+		ElseifKeyword,
+		EmptyKeyword,
+		EnableKeyword,
+		EnclosedKeyword,
+		EncryptionKeyword,
+		EndKeyword,
+		EndsKeyword,
+		EnforcedKeyword,
+		EngineKeyword,
+		EngineAttributeKeyword,
+		EnginesKeyword,
+		EnumKeyword,
+		ErrorKeyword,
+		ErrorsKeyword,
+		EscapeKeyword,
+		EscapedKeyword,
+		EventKeyword,
+		EventsKeyword,
+		EveryKeyword,
+		ExceptKeyword,
+		ExchangeKeyword,
+		ExcludeKeyword,
+		ExecuteKeyword,
+		ExistsKeyword,
+		ExitKeyword,
+		ExpansionKeyword,
+		ExpireKeyword,
+		ExplainKeyword,
+		ExportKeyword,
+		ExtendedKeyword,
+		ExtentSizeKeyword,
+		FactorKeyword,
+		FailedLoginAttemptsKeyword,
+		FalseKeyword,
+		FastKeyword,
+		FaultsKeyword,
+		FetchKeyword,
+		FieldsKeyword,
+		FileKeyword,
+		FileBlockSizeKeyword,
+		FilterKeyword,
+		FinishKeyword,
+		FirstKeyword,
+		FirstValueKeyword,
+		FixedKeyword,
+		FloatKeyword,
+		Float4Keyword,
+		Float8Keyword,
+		FlushKeyword,
+		FollowingKeyword,
+		FollowsKeyword,
+		ForKeyword,
+		ForceKeyword,
+		ForeignKeyword,
+		FormatKeyword,
+		FoundKeyword,
+		From,
+		FullKeyword,
+		FulltextKeyword,
+		FunctionKeyword,
+		GeneralKeyword,
+		GeneratedKeyword,
+		GeomcollectionKeyword,
+		GeometryKeyword,
+		GeometrycollectionKeyword,
+		GetKeyword,
+		GetFormatKeyword,
+		GetMasterPublicKeyKeyword,
+		GetSourcePublicKeyKeyword,
+		GlobalKeyword,
+		GrantKeyword,
+		GrantsKeyword,
+		GroupKeyword,
+		GroupReplicationKeyword,
+		GroupingKeyword,
+		GroupsKeyword,
+		GtidOnlyKeyword,
+		HandlerKeyword,
+		HashKeyword,
+		HavingKeyword,
+		HelpKeyword,
+		HighPriorityKeyword,
+		HistogramKeyword,
+		HistoryKeyword,
+		HostKeyword,
+		HostsKeyword,
+		HourKeyword,
+		HourMicrosecondKeyword,
+		HourMinuteKeyword,
+		HourSecondKeyword,
+		IdentifiedKeyword,
+		IfKeyword,
+		IgnoreKeyword,
+		IgnoreServerIdsKeyword,
+		ImportKeyword,
+		InKeyword,
+		InactiveKeyword,
+		IndexKeyword,
+		IndexesKeyword,
+		InfileKeyword,
+		InitialKeyword,
+		InitialSizeKeyword,
+		InitiateKeyword,
+		InnerKeyword,
+		InoutKeyword,
+		InsensitiveKeyword,
+		InsertKeyword,
+		InsertMethodKeyword,
+		InstallKeyword,
+		InstanceKeyword,
+		IntKeyword,
+		Int1Keyword,
+		Int2Keyword,
+		Int3Keyword,
+		Int4Keyword,
+		Int8Keyword,
+		IntegerKeyword,
+		IntervalKeyword,
+		IntoKeyword,
+		InvisibleKeyword,
+		InvokerKeyword,
+		IoKeyword,
+		IoAfterGtidsKeyword,
+		IoBeforeGtidsKeyword,
+		IoThreadKeyword,
+		IpcKeyword,
+		IsKeyword,
+		IsolationKeyword,
+		IssuerKeyword,
+		IterateKeyword,
+		JoinKeyword,
+		JsonKeyword,
+		JsonTableKeyword,
+		JsonValueKeyword,
+		KeyKeyword,
+		KeyBlockSizeKeyword,
+		KeyringKeyword,
+		KeysKeyword,
+		KillKeyword,
+		LagKeyword,
+		LanguageKeyword,
+		LastKeyword,
+		LastValueKeyword,
+		LateralKeyword,
+		LeadKeyword,
+		LeadingKeyword,
+		LeaveKeyword,
+		LeavesKeyword,
+		LeftKeyword,
+		LessKeyword,
+		LevelKeyword,
+		LikeKeyword,
+		LimitKeyword,
+		LinearKeyword,
+		LinesKeyword,
+		LinestringKeyword,
+		ListKeyword,
+		LoadKeyword,
+		LocalKeyword,
+		LocaltimeKeyword,
+		LocaltimestampKeyword,
+		LockKeyword,
+		LockedKeyword,
+		LocksKeyword,
+		LogfileKeyword,
+		LogsKeyword,
+		LongKeyword,
+		LongblobKeyword,
+		LongtextKeyword,
+		LoopKeyword,
+		LowPriorityKeyword,
+		MasterKeyword,
+		MasterAutoPositionKeyword,
+		MasterBindKeyword,
+		MasterCompressionAlgorithmsKeyword,
+		MasterConnectRetryKeyword,
+		MasterDelayKeyword,
+		MasterHeartbeatPeriodKeyword,
+		MasterHostKeyword,
+		MasterLogFileKeyword,
+		MasterLogPosKeyword,
+		MasterPasswordKeyword,
+		MasterPortKeyword,
+		MasterPublicKeyPathKeyword,
+		MasterRetryCountKeyword,
+		MasterSslKeyword,
+		MasterSslCaKeyword,
+		MasterSslCapathKeyword,
+		MasterSslCertKeyword,
+		MasterSslCipherKeyword,
+		MasterSslCrlKeyword,
+		MasterSslCrlpathKeyword,
+		MasterSslKeyKeyword,
+		MasterSslVerifyServerCertKeyword,
+		MasterTlsCiphersuitesKeyword,
+		MasterTlsVersionKeyword,
+		MasterUserKeyword,
+		MasterZstdCompressionLevelKeyword,
+		MatchKeyword,
+		MaxConnectionsPerHourKeyword,
+		MaxQueriesPerHourKeyword,
+		MaxRowsKeyword,
+		MaxSizeKeyword,
+		MaxUpdatesPerHourKeyword,
+		MaxUserConnectionsKeyword,
+		MaxvalueKeyword,
+		MediumKeyword,
+		MediumblobKeyword,
+		MediumintKeyword,
+		MediumtextKeyword,
+		MemberKeyword,
+		MemoryKeyword,
+		MergeKeyword,
+		MessageTextKeyword,
+		MicrosecondKeyword,
+		MiddleintKeyword,
+		MigrateKeyword,
+		MinRowsKeyword,
+		MinuteKeyword,
+		MinuteMicrosecondKeyword,
+		MinuteSecondKeyword,
+		ModKeyword,
+		ModeKeyword,
+		ModifiesKeyword,
+		ModifyKeyword,
+		MonthKeyword,
+		MultilinestringKeyword,
+		MultipointKeyword,
+		MultipolygonKeyword,
+		MutexKeyword,
+		MysqlErrnoKeyword,
+		NameKeyword,
+		NamesKeyword,
+		NationalKeyword,
+		NaturalKeyword,
+		NcharKeyword,
+		NdbKeyword,
+		NdbclusterKeyword,
+		NestedKeyword,
+		NetworkNamespaceKeyword,
+		NeverKeyword,
+		NewKeyword,
+		NextKeyword,
+		NoKeyword,
+		NoWaitKeyword,
+		NoWriteToBinlogKeyword,
+		NodegroupKeyword,
+		NoneKeyword,
+		NotKeyword,
+		NowaitKeyword,
+		NthValueKeyword,
+		NtileKeyword,
+		NullKeyword,
+		NullsKeyword,
+		NumberKeyword,
+		NumericKeyword,
+		NvarcharKeyword,
+		OfKeyword,
+		OffKeyword,
+		OffsetKeyword,
+		OjKeyword,
+		OldKeyword,
+		OnKeyword,
+		OneKeyword,
+		OnlyKeyword,
+		OpenKeyword,
+		OptimizeKeyword,
+		OptimizerCostsKeyword,
+		OptionKeyword,
+		OptionalKeyword,
+		OptionallyKeyword,
+		OptionsKeyword,
+		Or,
+		OrderKeyword,
+		OrdinalityKeyword,
+		OrganizationKeyword,
+		OthersKeyword,
+		OutKeyword,
+		OuterKeyword,
+		OutfileKeyword,
+		OverKeyword,
+		OwnerKeyword,
+		PackKeysKeyword,
+		PageKeyword,
+		ParserKeyword,
+		PartialKeyword,
+		PartitionKeyword,
+		PartitioningKeyword,
+		PartitionsKeyword,
+		PasswordKeyword,
+		PasswordLockTimeKeyword,
+		PathKeyword,
+		PercentRankKeyword,
+		PersistKeyword,
+		PersistOnlyKeyword,
+		PhaseKeyword,
+		PluginKeyword,
+		PluginDirKeyword,
+		PluginsKeyword,
+		PointKeyword,
+		PolygonKeyword,
+		PortKeyword,
+		PrecedesKeyword,
+		PrecedingKeyword,
+		PrecisionKeyword,
+		PrepareKeyword,
+		PreserveKeyword,
+		PrevKeyword,
+		PrimaryKeyword,
+		PrivilegeChecksUserKeyword,
+		PrivilegesKeyword,
+		ProcedureKeyword,
+		ProcessKeyword,
+		ProcesslistKeyword,
+		ProfileKeyword,
+		ProfilesKeyword,
+		ProxyKeyword,
+		PurgeKeyword,
+		QuarterKeyword,
+		QueryKeyword,
+		QuickKeyword,
+		RandomKeyword,
+		RangeKeyword,
+		RankKeyword,
+		ReadKeyword,
+		ReadOnlyKeyword,
+		ReadWriteKeyword,
+		ReadsKeyword,
+		RealKeyword,
+		RebuildKeyword,
+		RecoverKeyword,
+		RecursiveKeyword,
+		RedoBufferSizeKeyword,
+		RedundantKeyword,
+		ReferenceKeyword,
+		ReferencesKeyword,
+		RegexpKeyword,
+		RegistrationKeyword,
+		RelayKeyword,
+		RelayLogFileKeyword,
+		RelayLogPosKeyword,
+		RelayThreadKeyword,
+		RelaylogKeyword,
+		ReleaseKeyword,
+		ReloadKeyword,
+		RemoveKeyword,
+		RenameKeyword,
+		ReorganizeKeyword,
+		RepairKeyword,
+		RepeatKeyword,
+		RepeatableKeyword,
+		ReplaceKeyword,
+		ReplicaKeyword,
+		ReplicasKeyword,
+		ReplicateDoDbKeyword,
+		ReplicateDoTableKeyword,
+		ReplicateIgnoreDbKeyword,
+		ReplicateIgnoreTableKeyword,
+		ReplicateRewriteDbKeyword,
+		ReplicateWildDoTableKeyword,
+		ReplicateWildIgnoreTableKeyword,
+		ReplicationKeyword,
+		RequireKeyword,
+		RequireRowFormatKeyword,
+		RequireTablePrimaryKeyCheckKeyword,
+		ResetKeyword,
+		ResignalKeyword,
+		ResourceKeyword,
+		RespectKeyword,
+		RestartKeyword,
+		RestoreKeyword,
+		RestrictKeyword,
+		ResumeKeyword,
+		RetainKeyword,
+		ReturnKeyword,
+		ReturnedSqlstateKeyword,
+		ReturningKeyword,
+		ReturnsKeyword,
+		ReuseKeyword,
+		ReverseKeyword,
+		RevokeKeyword,
+		RightKeyword,
+		RlikeKeyword,
+		RoleKeyword,
+		RollbackKeyword,
+		RollupKeyword,
+		RotateKeyword,
+		RoutineKeyword,
+		RowKeyword,
+		RowCountKeyword,
+		RowFormatKeyword,
+		RowNumberKeyword,
+		RowsKeyword,
+		RtreeKeyword,
+		SavepointKeyword,
+		ScheduleKeyword,
+		SchemaKeyword,
+		SchemaNameKeyword,
+		SchemasKeyword,
+		SecondKeyword,
+		SecondMicrosecondKeyword,
+		SecondaryKeyword,
+		SecondaryEngineKeyword,
+		SecondaryEngineAttributeKeyword,
+		SecondaryLoadKeyword,
+		SecondaryUnloadKeyword,
+		SecurityKeyword,
+		Select,
+		SensitiveKeyword,
+		SeparatorKeyword,
+		SerialKeyword,
+		SerializableKeyword,
+		ServerKeyword,
+		SessionKeyword,
+		SetKeyword,
+		ShareKeyword,
+		ShowKeyword,
+		ShutdownKeyword,
+		SignalKeyword,
+		SignedKeyword,
+		SimpleKeyword,
+		SkipKeyword,
+		SlaveKeyword,
+		SlowKeyword,
+		SmallintKeyword,
+		SnapshotKeyword,
+		SocketKeyword,
+		SomeKeyword,
+		SonameKeyword,
+		SoundsKeyword,
+		SourceKeyword,
+		SourceAutoPositionKeyword,
+		SourceBindKeyword,
+		SourceCompressionAlgorithmsKeyword,
+		SourceConnectRetryKeyword,
+		SourceConnectionAutoFailoverKeyword,
+		SourceDelayKeyword,
+		SourceHeartbeatPeriodKeyword,
+		SourceHostKeyword,
+		SourceLogFileKeyword,
+		SourceLogPosKeyword,
+		SourcePasswordKeyword,
+		SourcePortKeyword,
+		SourcePublicKeyPathKeyword,
+		SourceRetryCountKeyword,
+		SourceSslKeyword,
+		SourceSslCaKeyword,
+		SourceSslCapathKeyword,
+		SourceSslCertKeyword,
+		SourceSslCipherKeyword,
+		SourceSslCrlKeyword,
+		SourceSslCrlpathKeyword,
+		SourceSslKeyKeyword,
+		SourceSslVerifyServerCertKeyword,
+		SourceTlsCiphersuitesKeyword,
+		SourceTlsVersionKeyword,
+		SourceUserKeyword,
+		SourceZstdCompressionLevelKeyword,
+		SpatialKeyword,
+		SpecificKeyword,
+		SqlKeyword,
+		SqlAfterGtidsKeyword,
+		SqlAfterMtsGapsKeyword,
+		SqlBeforeGtidsKeyword,
+		SqlBigResultKeyword,
+		SqlBufferResultKeyword,
+		SqlCalcFoundRowsKeyword,
+		SqlNoCacheKeyword,
+		SqlSmallResultKeyword,
+		SqlThreadKeyword,
+		SqlTsiDayKeyword,
+		SqlTsiHourKeyword,
+		SqlTsiMinuteKeyword,
+		SqlTsiMonthKeyword,
+		SqlTsiQuarterKeyword,
+		SqlTsiSecondKeyword,
+		SqlTsiWeekKeyword,
+		SqlTsiYearKeyword,
+		SqlexceptionKeyword,
+		SqlstateKeyword,
+		SqlwarningKeyword,
+		SridKeyword,
+		SslKeyword,
+		StackedKeyword,
+		StartKeyword,
+		StartingKeyword,
+		StartsKeyword,
+		StatsAutoRecalcKeyword,
+		StatsPersistentKeyword,
+		StatsSamplePagesKeyword,
+		StatusKeyword,
+		StopKeyword,
+		StorageKeyword,
+		StoredKeyword,
+		StraightJoinKeyword,
+		StreamKeyword,
+		StringKeyword,
+		SubclassOriginKeyword,
+		SubjectKeyword,
+		SubpartitionKeyword,
+		SubpartitionsKeyword,
+		SuperKeyword,
+		SuspendKeyword,
+		SwapsKeyword,
+		SwitchesKeyword,
+		SystemKeyword,
+		TableKeyword,
+		TableChecksumKeyword,
+		TableNameKeyword,
+		TablesKeyword,
+		TablespaceKeyword,
+		TemporaryKeyword,
+		TemptableKeyword,
+		TerminatedKeyword,
+		TextKeyword,
+		ThanKeyword,
+		ThenKeyword,
+		ThreadPriorityKeyword,
+		TiesKeyword,
+		TimeKeyword,
+		TimestampKeyword,
+		TimestampaddKeyword,
+		TimestampdiffKeyword,
+		TinyblobKeyword,
+		TinyintKeyword,
+		TinytextKeyword,
+		TlsKeyword,
+		ToKeyword,
+		TrailingKeyword,
+		TransactionKeyword,
+		TriggerKeyword,
+		TriggersKeyword,
+		TrueKeyword,
+		TruncateKeyword,
+		TypeKeyword,
+		TypesKeyword,
+		UnboundedKeyword,
+		UncommittedKeyword,
+		UndefinedKeyword,
+		UndoKeyword,
+		UndoBufferSizeKeyword,
+		UndofileKeyword,
+		UnicodeKeyword,
+		UninstallKeyword,
+		UnionKeyword,
+		UniqueKeyword,
+		UnknownKeyword,
+		UnlockKeyword,
+		UnregisterKeyword,
+		UnsignedKeyword,
+		UntilKeyword,
+		UpdateKeyword,
+		UpgradeKeyword,
+		UsageKeyword,
+		UseKeyword,
+		UseFrmKeyword,
+		UserKeyword,
+		UserResourcesKeyword,
+		UsingKeyword,
+		UtcDateKeyword,
+		UtcTimeKeyword,
+		UtcTimestampKeyword,
+		ValidationKeyword,
+		ValueKeyword,
+		ValuesKeyword,
+		VarbinaryKeyword,
+		VarcharKeyword,
+		VarcharacterKeyword,
+		VariablesKeyword,
+		VaryingKeyword,
+		VcpuKeyword,
+		ViewKeyword,
+		VirtualKeyword,
+		VisibleKeyword,
+		WaitKeyword,
+		WarningsKeyword,
+		WeekKeyword,
+		WeightStringKeyword,
+		WhenKeyword,
+		Where,
+		WhileKeyword,
+		WindowKeyword,
+		WithKeyword,
+		WithoutKeyword,
+		WorkKeyword,
+		WrapperKeyword,
+		WriteKeyword,
+		X509Keyword,
+		XaKeyword,
+		XidKeyword,
+		XmlKeyword,
+		XorKeyword,
+		YearKeyword,
+		YearMonthKeyword,
+		ZerofillKeyword,
+		ZoneKeyword,
+	}
+	// keywordMap is the map of the upper case keyword and its attributes
+	keywordMap = map[string]keyword{
+		"ACCESSIBLE":                             {AccessibleKeyword, true},
+		"ACCOUNT":                                {AccountKeyword, false},
+		"ACTION":                                 {ActionKeyword, false},
+		"ACTIVE":                                 {ActiveKeyword, false},
+		"ADD":                                    {AddKeyword, true},
+		"ADMIN":                                  {AdminKeyword, false},
+		"AFTER":                                  {AfterKeyword, false},
+		"AGAINST":                                {AgainstKeyword, false},
+		"AGGREGATE":                              {AggregateKeyword, false},
+		"ALGORITHM":                              {AlgorithmKeyword, false},
+		"ALL":                                    {AllKeyword, true},
+		"ALTER":                                  {AlterKeyword, true},
+		"ALWAYS":                                 {AlwaysKeyword, false},
+		"ANALYZE":                                {AnalyzeKeyword, true},
+		"AND":                                    {And, true},
+		"ANY":                                    {AnyKeyword, false},
+		"ARRAY":                                  {ArrayKeyword, false},
+		"AS":                                     {As, true},
+		"ASC":                                    {AscKeyword, true},
+		"ASCII":                                  {AsciiKeyword, false},
+		"ASENSITIVE":                             {AsensitiveKeyword, true},
+		"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS": {AssignGtidsToAnonymousTransactionsKeyword, false},
+		"AT":                                     {AtKeyword, false},
+		"ATTRIBUTE":                              {AttributeKeyword, false},
+		"AUTHENTICATION":                         {AuthenticationKeyword, false},
+		"AUTO_INCREMENT":                         {AutoIncrementKeyword, false},
+		"AUTOEXTEND_SIZE":                        {AutoextendSizeKeyword, false},
+		"AVG":                                    {AvgKeyword, false},
+		"AVG_ROW_LENGTH":                         {AvgRowLengthKeyword, false},
+		"BACKUP":                                 {BackupKeyword, false},
+		"BEFORE":                                 {BeforeKeyword, true},
+		"BEGIN":                                  {BeginKeyword, false},
+		"BETWEEN":                                {BetweenKeyword, true},
+		"BIGINT":                                 {BigintKeyword, true},
+		"BINARY":                                 {BinaryKeyword, true},
+		"BINLOG":                                 {BinlogKeyword, false},
+		"BIT":                                    {BitKeyword, false},
+		"BLOB":                                   {BlobKeyword, true},
+		"BLOCK":                                  {BlockKeyword, false},
+		"BOOL":                                   {BoolKeyword, false},
+		"BOOLEAN":                                {BooleanKeyword, false},
+		"BOTH":                                   {BothKeyword, true},
+		"BTREE":                                  {BtreeKeyword, false},
+		"BUCKETS":                                {BucketsKeyword, false},
+		"BY":                                     {ByKeyword, true},
+		"BYTE":                                   {ByteKeyword, false},
+		"CACHE":                                  {CacheKeyword, false},
+		"CALL":                                   {CallKeyword, true},
+		"CASCADE":                                {CascadeKeyword, true},
+		"CASCADED":                               {CascadedKeyword, false},
+		"CASE":                                   {CaseKeyword, true},
+		"CATALOG_NAME":                           {CatalogNameKeyword, false},
+		"CHAIN":                                  {ChainKeyword, false},
+		"CHALLENGE_RESPONSE":                     {ChallengeResponseKeyword, false},
+		"CHANGE":                                 {ChangeKeyword, true},
+		"CHANGED":                                {ChangedKeyword, false},
+		"CHANNEL":                                {ChannelKeyword, false},
+		"CHAR":                                   {CharKeyword, true},
+		"CHARACTER":                              {CharacterKeyword, true},
+		"CHARSET":                                {CharsetKeyword, false},
+		"CHECK":                                  {CheckKeyword, true},
+		"CHECKSUM":                               {ChecksumKeyword, false},
+		"CIPHER":                                 {CipherKeyword, false},
+		"CLASS_ORIGIN":                           {ClassOriginKeyword, false},
+		"CLIENT":                                 {ClientKeyword, false},
+		"CLONE":                                  {CloneKeyword, false},
+		"CLOSE":                                  {CloseKeyword, false},
+		"COALESCE":                               {CoalesceKeyword, false},
+		"CODE":                                   {CodeKeyword, false},
+		"COLLATE":                                {CollateKeyword, true},
+		"COLLATION":                              {CollationKeyword, false},
+		"COLUMN":                                 {ColumnKeyword, true},
+		"COLUMN_FORMAT":                          {ColumnFormatKeyword, false},
+		"COLUMN_NAME":                            {ColumnNameKeyword, false},
+		"COLUMNS":                                {ColumnsKeyword, false},
+		"COMMENT":                                {CommentKeyword, false},
+		"COMMIT":                                 {CommitKeyword, false},
+		"COMMITTED":                              {CommittedKeyword, false},
+		"COMPACT":                                {CompactKeyword, false},
+		"COMPLETION":                             {CompletionKeyword, false},
+		"COMPONENT":                              {ComponentKeyword, false},
+		"COMPRESSED":                             {CompressedKeyword, false},
+		"COMPRESSION":                            {CompressionKeyword, false},
+		"CONCURRENT":                             {ConcurrentKeyword, false},
+		"CONDITION":                              {ConditionKeyword, true},
+		"CONNECTION":                             {ConnectionKeyword, false},
+		"CONSISTENT":                             {ConsistentKeyword, false},
+		"CONSTRAINT":                             {ConstraintKeyword, true},
+		"CONSTRAINT_CATALOG":                     {ConstraintCatalogKeyword, false},
+		"CONSTRAINT_NAME":                        {ConstraintNameKeyword, false},
+		"CONSTRAINT_SCHEMA":                      {ConstraintSchemaKeyword, false},
+		"CONTAINS":                               {ContainsKeyword, false},
+		"CONTEXT":                                {ContextKeyword, false},
+		"CONTINUE":                               {ContinueKeyword, true},
+		"CONVERT":                                {ConvertKeyword, true},
+		"CPU":                                    {CpuKeyword, false},
+		"CREATE":                                 {CreateKeyword, true},
+		"CROSS":                                  {CrossKeyword, true},
+		"CUBE":                                   {CubeKeyword, true},
+		"CUME_DIST":                              {CumeDistKeyword, true},
+		"CURRENT":                                {CurrentKeyword, false},
+		"CURRENT_DATE":                           {CurrentDateKeyword, true},
+		"CURRENT_TIME":                           {CurrentTimeKeyword, true},
+		"CURRENT_TIMESTAMP":                      {CurrentTimestampKeyword, true},
+		"CURRENT_USER":                           {CurrentUserKeyword, true},
+		"CURSOR":                                 {CursorKeyword, true},
+		"CURSOR_NAME":                            {CursorNameKeyword, false},
+		"DATA":                                   {DataKeyword, false},
+		"DATABASE":                               {DatabaseKeyword, true},
+		"DATABASES":                              {DatabasesKeyword, true},
+		"DATAFILE":                               {DatafileKeyword, false},
+		"DATE":                                   {DateKeyword, false},
+		"DATETIME":                               {DatetimeKeyword, false},
+		"DAY":                                    {DayKeyword, false},
+		"DAY_HOUR":                               {DayHourKeyword, true},
+		"DAY_MICROSECOND":                        {DayMicrosecondKeyword, true},
+		"DAY_MINUTE":                             {DayMinuteKeyword, true},
+		"DAY_SECOND":                             {DaySecondKeyword, true},
+		"DEALLOCATE":                             {DeallocateKeyword, false},
+		"DEC":                                    {DecKeyword, true},
+		"DECIMAL":                                {DecimalKeyword, true},
+		"DECLARE":                                {DeclareKeyword, true},
+		"DEFAULT":                                {DefaultKeyword, true},
+		"DEFAULT_AUTH":                           {DefaultAuthKeyword, false},
+		"DEFINER":                                {DefinerKeyword, false},
+		"DEFINITION":                             {DefinitionKeyword, false},
+		"DELAY_KEY_WRITE":                        {DelayKeyWriteKeyword, false},
+		"DELAYED":                                {DelayedKeyword, true},
+		"DELETE":                                 {DeleteKeyword, true},
+		"DENSE_RANK":                             {DenseRankKeyword, true},
+		"DESC":                                   {DescKeyword, true},
+		"DESCRIBE":                               {DescribeKeyword, true},
+		"DESCRIPTION":                            {DescriptionKeyword, false},
+		"DETERMINISTIC":                          {DeterministicKeyword, true},
+		"DIAGNOSTICS":                            {DiagnosticsKeyword, false},
+		"DIRECTORY":                              {DirectoryKeyword, false},
+		"DISABLE":                                {DisableKeyword, false},
+		"DISCARD":                                {DiscardKeyword, false},
+		"DISK":                                   {DiskKeyword, false},
+		"DISTINCT":                               {DistinctKeyword, true},
+		"DISTINCTROW":                            {DistinctrowKeyword, true},
+		"DIV":                                    {DivKeyword, true},
+		"DO":                                     {DoKeyword, false},
+		"DOUBLE":                                 {DoubleKeyword, true},
+		"DROP":                                   {DropKeyword, true},
+		"DUAL":                                   {DualKeyword, true},
+		"DUMPFILE":                               {DumpfileKeyword, false},
+		"DUPLICATE":                              {DuplicateKeyword, false},
+		"DYNAMIC":                                {DynamicKeyword, false},
+		"EACH":                                   {EachKeyword, true},
+		"ELSE":                                   {ElseKeyword, true},
+		"ELSEIF":                                 {ElseifKeyword, true},
+		"EMPTY":                                  {EmptyKeyword, true},
+		"ENABLE":                                 {EnableKeyword, false},
+		"ENCLOSED":                               {EnclosedKeyword, true},
+		"ENCRYPTION":                             {EncryptionKeyword, false},
+		"END":                                    {EndKeyword, false},
+		"ENDS":                                   {EndsKeyword, false},
+		"ENFORCED":                               {EnforcedKeyword, false},
+		"ENGINE":                                 {EngineKeyword, false},
+		"ENGINE_ATTRIBUTE":                       {EngineAttributeKeyword, false},
+		"ENGINES":                                {EnginesKeyword, false},
+		"ENUM":                                   {EnumKeyword, false},
+		"ERROR":                                  {ErrorKeyword, false},
+		"ERRORS":                                 {ErrorsKeyword, false},
+		"ESCAPE":                                 {EscapeKeyword, false},
+		"ESCAPED":                                {EscapedKeyword, true},
+		"EVENT":                                  {EventKeyword, false},
+		"EVENTS":                                 {EventsKeyword, false},
+		"EVERY":                                  {EveryKeyword, false},
+		"EXCEPT":                                 {ExceptKeyword, true},
+		"EXCHANGE":                               {ExchangeKeyword, false},
+		"EXCLUDE":                                {ExcludeKeyword, false},
+		"EXECUTE":                                {ExecuteKeyword, false},
+		"EXISTS":                                 {ExistsKeyword, true},
+		"EXIT":                                   {ExitKeyword, true},
+		"EXPANSION":                              {ExpansionKeyword, false},
+		"EXPIRE":                                 {ExpireKeyword, false},
+		"EXPLAIN":                                {ExplainKeyword, true},
+		"EXPORT":                                 {ExportKeyword, false},
+		"EXTENDED":                               {ExtendedKeyword, false},
+		"EXTENT_SIZE":                            {ExtentSizeKeyword, false},
+		"FACTOR":                                 {FactorKeyword, false},
+		"FAILED_LOGIN_ATTEMPTS":                  {FailedLoginAttemptsKeyword, false},
+		"FALSE":                                  {FalseKeyword, true},
+		"FAST":                                   {FastKeyword, false},
+		"FAULTS":                                 {FaultsKeyword, false},
+		"FETCH":                                  {FetchKeyword, true},
+		"FIELDS":                                 {FieldsKeyword, false},
+		"FILE":                                   {FileKeyword, false},
+		"FILE_BLOCK_SIZE":                        {FileBlockSizeKeyword, false},
+		"FILTER":                                 {FilterKeyword, false},
+		"FINISH":                                 {FinishKeyword, false},
+		"FIRST":                                  {FirstKeyword, false},
+		"FIRST_VALUE":                            {FirstValueKeyword, true},
+		"FIXED":                                  {FixedKeyword, false},
+		"FLOAT":                                  {FloatKeyword, true},
+		"FLOAT4":                                 {Float4Keyword, true},
+		"FLOAT8":                                 {Float8Keyword, true},
+		"FLUSH":                                  {FlushKeyword, false},
+		"FOLLOWING":                              {FollowingKeyword, false},
+		"FOLLOWS":                                {FollowsKeyword, false},
+		"FOR":                                    {ForKeyword, true},
+		"FORCE":                                  {ForceKeyword, true},
+		"FOREIGN":                                {ForeignKeyword, true},
+		"FORMAT":                                 {FormatKeyword, false},
+		"FOUND":                                  {FoundKeyword, false},
+		"FROM":                                   {From, true},
+		"FULL":                                   {FullKeyword, false},
+		"FULLTEXT":                               {FulltextKeyword, true},
+		"FUNCTION":                               {FunctionKeyword, true},
+		"GENERAL":                                {GeneralKeyword, false},
+		"GENERATED":                              {GeneratedKeyword, true},
+		"GEOMCOLLECTION":                         {GeomcollectionKeyword, false},
+		"GEOMETRY":                               {GeometryKeyword, false},
+		"GEOMETRYCOLLECTION":                     {GeometrycollectionKeyword, false},
+		"GET":                                    {GetKeyword, true},
+		"GET_FORMAT":                             {GetFormatKeyword, false},
+		"GET_MASTER_PUBLIC_KEY":                  {GetMasterPublicKeyKeyword, false},
+		"GET_SOURCE_PUBLIC_KEY":                  {GetSourcePublicKeyKeyword, false},
+		"GLOBAL":                                 {GlobalKeyword, false},
+		"GRANT":                                  {GrantKeyword, true},
+		"GRANTS":                                 {GrantsKeyword, false},
+		"GROUP":                                  {GroupKeyword, true},
+		"GROUP_REPLICATION":                      {GroupReplicationKeyword, false},
+		"GROUPING":                               {GroupingKeyword, true},
+		"GROUPS":                                 {GroupsKeyword, true},
+		"GTID_ONLY":                              {GtidOnlyKeyword, false},
+		"HANDLER":                                {HandlerKeyword, false},
+		"HASH":                                   {HashKeyword, false},
+		"HAVING":                                 {HavingKeyword, true},
+		"HELP":                                   {HelpKeyword, false},
+		"HIGH_PRIORITY":                          {HighPriorityKeyword, true},
+		"HISTOGRAM":                              {HistogramKeyword, false},
+		"HISTORY":                                {HistoryKeyword, false},
+		"HOST":                                   {HostKeyword, false},
+		"HOSTS":                                  {HostsKeyword, false},
+		"HOUR":                                   {HourKeyword, false},
+		"HOUR_MICROSECOND":                       {HourMicrosecondKeyword, true},
+		"HOUR_MINUTE":                            {HourMinuteKeyword, true},
+		"HOUR_SECOND":                            {HourSecondKeyword, true},
+		"IDENTIFIED":                             {IdentifiedKeyword, false},
+		"IF":                                     {IfKeyword, true},
+		"IGNORE":                                 {IgnoreKeyword, true},
+		"IGNORE_SERVER_IDS":                      {IgnoreServerIdsKeyword, false},
+		"IMPORT":                                 {ImportKeyword, false},
+		"IN":                                     {InKeyword, true},
+		"INACTIVE":                               {InactiveKeyword, false},
+		"INDEX":                                  {IndexKeyword, true},
+		"INDEXES":                                {IndexesKeyword, false},
+		"INFILE":                                 {InfileKeyword, true},
+		"INITIAL":                                {InitialKeyword, false},
+		"INITIAL_SIZE":                           {InitialSizeKeyword, false},
+		"INITIATE":                               {InitiateKeyword, false},
+		"INNER":                                  {InnerKeyword, true},
+		"INOUT":                                  {InoutKeyword, true},
+		"INSENSITIVE":                            {InsensitiveKeyword, true},
+		"INSERT":                                 {InsertKeyword, true},
+		"INSERT_METHOD":                          {InsertMethodKeyword, false},
+		"INSTALL":                                {InstallKeyword, false},
+		"INSTANCE":                               {InstanceKeyword, false},
+		"INT":                                    {IntKeyword, true},
+		"INT1":                                   {Int1Keyword, true},
+		"INT2":                                   {Int2Keyword, true},
+		"INT3":                                   {Int3Keyword, true},
+		"INT4":                                   {Int4Keyword, true},
+		"INT8":                                   {Int8Keyword, true},
+		"INTEGER":                                {IntegerKeyword, true},
+		"INTERVAL":                               {IntervalKeyword, true},
+		"INTO":                                   {IntoKeyword, true},
+		"INVISIBLE":                              {InvisibleKeyword, false},
+		"INVOKER":                                {InvokerKeyword, false},
+		"IO":                                     {IoKeyword, false},
+		"IO_AFTER_GTIDS":                         {IoAfterGtidsKeyword, true},
+		"IO_BEFORE_GTIDS":                        {IoBeforeGtidsKeyword, true},
+		"IO_THREAD":                              {IoThreadKeyword, false},
+		"IPC":                                    {IpcKeyword, false},
+		"IS":                                     {IsKeyword, true},
+		"ISOLATION":                              {IsolationKeyword, false},
+		"ISSUER":                                 {IssuerKeyword, false},
+		"ITERATE":                                {IterateKeyword, true},
+		"JOIN":                                   {JoinKeyword, true},
+		"JSON":                                   {JsonKeyword, false},
+		"JSON_TABLE":                             {JsonTableKeyword, true},
+		"JSON_VALUE":                             {JsonValueKeyword, false},
+		"KEY":                                    {KeyKeyword, true},
+		"KEY_BLOCK_SIZE":                         {KeyBlockSizeKeyword, false},
+		"KEYRING":                                {KeyringKeyword, false},
+		"KEYS":                                   {KeysKeyword, true},
+		"KILL":                                   {KillKeyword, true},
+		"LAG":                                    {LagKeyword, true},
+		"LANGUAGE":                               {LanguageKeyword, false},
+		"LAST":                                   {LastKeyword, false},
+		"LAST_VALUE":                             {LastValueKeyword, true},
+		"LATERAL":                                {LateralKeyword, true},
+		"LEAD":                                   {LeadKeyword, true},
+		"LEADING":                                {LeadingKeyword, true},
+		"LEAVE":                                  {LeaveKeyword, true},
+		"LEAVES":                                 {LeavesKeyword, false},
+		"LEFT":                                   {LeftKeyword, true},
+		"LESS":                                   {LessKeyword, false},
+		"LEVEL":                                  {LevelKeyword, false},
+		"LIKE":                                   {LikeKeyword, true},
+		"LIMIT":                                  {LimitKeyword, true},
+		"LINEAR":                                 {LinearKeyword, true},
+		"LINES":                                  {LinesKeyword, true},
+		"LINESTRING":                             {LinestringKeyword, false},
+		"LIST":                                   {ListKeyword, false},
+		"LOAD":                                   {LoadKeyword, true},
+		"LOCAL":                                  {LocalKeyword, false},
+		"LOCALTIME":                              {LocaltimeKeyword, true},
+		"LOCALTIMESTAMP":                         {LocaltimestampKeyword, true},
+		"LOCK":                                   {LockKeyword, true},
+		"LOCKED":                                 {LockedKeyword, false},
+		"LOCKS":                                  {LocksKeyword, false},
+		"LOGFILE":                                {LogfileKeyword, false},
+		"LOGS":                                   {LogsKeyword, false},
+		"LONG":                                   {LongKeyword, true},
+		"LONGBLOB":                               {LongblobKeyword, true},
+		"LONGTEXT":                               {LongtextKeyword, true},
+		"LOOP":                                   {LoopKeyword, true},
+		"LOW_PRIORITY":                           {LowPriorityKeyword, true},
+		"MASTER":                                 {MasterKeyword, false},
+		"MASTER_AUTO_POSITION":                   {MasterAutoPositionKeyword, false},
+		"MASTER_BIND":                            {MasterBindKeyword, true},
+		"MASTER_COMPRESSION_ALGORITHMS":          {MasterCompressionAlgorithmsKeyword, false},
+		"MASTER_CONNECT_RETRY":                   {MasterConnectRetryKeyword, false},
+		"MASTER_DELAY":                           {MasterDelayKeyword, false},
+		"MASTER_HEARTBEAT_PERIOD":                {MasterHeartbeatPeriodKeyword, false},
+		"MASTER_HOST":                            {MasterHostKeyword, false},
+		"MASTER_LOG_FILE":                        {MasterLogFileKeyword, false},
+		"MASTER_LOG_POS":                         {MasterLogPosKeyword, false},
+		"MASTER_PASSWORD":                        {MasterPasswordKeyword, false},
+		"MASTER_PORT":                            {MasterPortKeyword, false},
+		"MASTER_PUBLIC_KEY_PATH":                 {MasterPublicKeyPathKeyword, false},
+		"MASTER_RETRY_COUNT":                     {MasterRetryCountKeyword, false},
+		"MASTER_SSL":                             {MasterSslKeyword, false},
+		"MASTER_SSL_CA":                          {MasterSslCaKeyword, false},
+		"MASTER_SSL_CAPATH":                      {MasterSslCapathKeyword, false},
+		"MASTER_SSL_CERT":                        {MasterSslCertKeyword, false},
+		"MASTER_SSL_CIPHER":                      {MasterSslCipherKeyword, false},
+		"MASTER_SSL_CRL":                         {MasterSslCrlKeyword, false},
+		"MASTER_SSL_CRLPATH":                     {MasterSslCrlpathKeyword, false},
+		"MASTER_SSL_KEY":                         {MasterSslKeyKeyword, false},
+		"MASTER_SSL_VERIFY_SERVER_CERT":          {MasterSslVerifyServerCertKeyword, true},
+		"MASTER_TLS_CIPHERSUITES":                {MasterTlsCiphersuitesKeyword, false},
+		"MASTER_TLS_VERSION":                     {MasterTlsVersionKeyword, false},
+		"MASTER_USER":                            {MasterUserKeyword, false},
+		"MASTER_ZSTD_COMPRESSION_LEVEL":          {MasterZstdCompressionLevelKeyword, false},
+		"MATCH":                                  {MatchKeyword, true},
+		"MAX_CONNECTIONS_PER_HOUR":               {MaxConnectionsPerHourKeyword, false},
+		"MAX_QUERIES_PER_HOUR":                   {MaxQueriesPerHourKeyword, false},
+		"MAX_ROWS":                               {MaxRowsKeyword, false},
+		"MAX_SIZE":                               {MaxSizeKeyword, false},
+		"MAX_UPDATES_PER_HOUR":                   {MaxUpdatesPerHourKeyword, false},
+		"MAX_USER_CONNECTIONS":                   {MaxUserConnectionsKeyword, false},
+		"MAXVALUE":                               {MaxvalueKeyword, true},
+		"MEDIUM":                                 {MediumKeyword, false},
+		"MEDIUMBLOB":                             {MediumblobKeyword, true},
+		"MEDIUMINT":                              {MediumintKeyword, true},
+		"MEDIUMTEXT":                             {MediumtextKeyword, true},
+		"MEMBER":                                 {MemberKeyword, false},
+		"MEMORY":                                 {MemoryKeyword, false},
+		"MERGE":                                  {MergeKeyword, false},
+		"MESSAGE_TEXT":                           {MessageTextKeyword, false},
+		"MICROSECOND":                            {MicrosecondKeyword, false},
+		"MIDDLEINT":                              {MiddleintKeyword, true},
+		"MIGRATE":                                {MigrateKeyword, false},
+		"MIN_ROWS":                               {MinRowsKeyword, false},
+		"MINUTE":                                 {MinuteKeyword, false},
+		"MINUTE_MICROSECOND":                     {MinuteMicrosecondKeyword, true},
+		"MINUTE_SECOND":                          {MinuteSecondKeyword, true},
+		"MOD":                                    {ModKeyword, true},
+		"MODE":                                   {ModeKeyword, false},
+		"MODIFIES":                               {ModifiesKeyword, true},
+		"MODIFY":                                 {ModifyKeyword, false},
+		"MONTH":                                  {MonthKeyword, false},
+		"MULTILINESTRING":                        {MultilinestringKeyword, false},
+		"MULTIPOINT":                             {MultipointKeyword, false},
+		"MULTIPOLYGON":                           {MultipolygonKeyword, false},
+		"MUTEX":                                  {MutexKeyword, false},
+		"MYSQL_ERRNO":                            {MysqlErrnoKeyword, false},
+		"NAME":                                   {NameKeyword, false},
+		"NAMES":                                  {NamesKeyword, false},
+		"NATIONAL":                               {NationalKeyword, false},
+		"NATURAL":                                {NaturalKeyword, true},
+		"NCHAR":                                  {NcharKeyword, false},
+		"NDB":                                    {NdbKeyword, false},
+		"NDBCLUSTER":                             {NdbclusterKeyword, false},
+		"NESTED":                                 {NestedKeyword, false},
+		"NETWORK_NAMESPACE":                      {NetworkNamespaceKeyword, false},
+		"NEVER":                                  {NeverKeyword, false},
+		"NEW":                                    {NewKeyword, false},
+		"NEXT":                                   {NextKeyword, false},
+		"NO":                                     {NoKeyword, false},
+		"NO_WAIT":                                {NoWaitKeyword, false},
+		"NO_WRITE_TO_BINLOG":                     {NoWriteToBinlogKeyword, true},
+		"NODEGROUP":                              {NodegroupKeyword, false},
+		"NONE":                                   {NoneKeyword, false},
+		"NOT":                                    {NotKeyword, true},
+		"NOWAIT":                                 {NowaitKeyword, false},
+		"NTH_VALUE":                              {NthValueKeyword, true},
+		"NTILE":                                  {NtileKeyword, true},
+		"NULL":                                   {NullKeyword, true},
+		"NULLS":                                  {NullsKeyword, false},
+		"NUMBER":                                 {NumberKeyword, false},
+		"NUMERIC":                                {NumericKeyword, true},
+		"NVARCHAR":                               {NvarcharKeyword, false},
+		"OF":                                     {OfKeyword, true},
+		"OFF":                                    {OffKeyword, false},
+		"OFFSET":                                 {OffsetKeyword, false},
+		"OJ":                                     {OjKeyword, false},
+		"OLD":                                    {OldKeyword, false},
+		"ON":                                     {OnKeyword, true},
+		"ONE":                                    {OneKeyword, false},
+		"ONLY":                                   {OnlyKeyword, false},
+		"OPEN":                                   {OpenKeyword, false},
+		"OPTIMIZE":                               {OptimizeKeyword, true},
+		"OPTIMIZER_COSTS":                        {OptimizerCostsKeyword, true},
+		"OPTION":                                 {OptionKeyword, true},
+		"OPTIONAL":                               {OptionalKeyword, false},
+		"OPTIONALLY":                             {OptionallyKeyword, true},
+		"OPTIONS":                                {OptionsKeyword, false},
+		"OR":                                     {Or, true},
+		"ORDER":                                  {OrderKeyword, true},
+		"ORDINALITY":                             {OrdinalityKeyword, false},
+		"ORGANIZATION":                           {OrganizationKeyword, false},
+		"OTHERS":                                 {OthersKeyword, false},
+		"OUT":                                    {OutKeyword, true},
+		"OUTER":                                  {OuterKeyword, true},
+		"OUTFILE":                                {OutfileKeyword, true},
+		"OVER":                                   {OverKeyword, true},
+		"OWNER":                                  {OwnerKeyword, false},
+		"PACK_KEYS":                              {PackKeysKeyword, false},
+		"PAGE":                                   {PageKeyword, false},
+		"PARSER":                                 {ParserKeyword, false},
+		"PARTIAL":                                {PartialKeyword, false},
+		"PARTITION":                              {PartitionKeyword, true},
+		"PARTITIONING":                           {PartitioningKeyword, false},
+		"PARTITIONS":                             {PartitionsKeyword, false},
+		"PASSWORD":                               {PasswordKeyword, false},
+		"PASSWORD_LOCK_TIME":                     {PasswordLockTimeKeyword, false},
+		"PATH":                                   {PathKeyword, false},
+		"PERCENT_RANK":                           {PercentRankKeyword, true},
+		"PERSIST":                                {PersistKeyword, false},
+		"PERSIST_ONLY":                           {PersistOnlyKeyword, false},
+		"PHASE":                                  {PhaseKeyword, false},
+		"PLUGIN":                                 {PluginKeyword, false},
+		"PLUGIN_DIR":                             {PluginDirKeyword, false},
+		"PLUGINS":                                {PluginsKeyword, false},
+		"POINT":                                  {PointKeyword, false},
+		"POLYGON":                                {PolygonKeyword, false},
+		"PORT":                                   {PortKeyword, false},
+		"PRECEDES":                               {PrecedesKeyword, false},
+		"PRECEDING":                              {PrecedingKeyword, false},
+		"PRECISION":                              {PrecisionKeyword, true},
+		"PREPARE":                                {PrepareKeyword, false},
+		"PRESERVE":                               {PreserveKeyword, false},
+		"PREV":                                   {PrevKeyword, false},
+		"PRIMARY":                                {PrimaryKeyword, true},
+		"PRIVILEGE_CHECKS_USER":                  {PrivilegeChecksUserKeyword, false},
+		"PRIVILEGES":                             {PrivilegesKeyword, false},
+		"PROCEDURE":                              {ProcedureKeyword, true},
+		"PROCESS":                                {ProcessKeyword, false},
+		"PROCESSLIST":                            {ProcesslistKeyword, false},
+		"PROFILE":                                {ProfileKeyword, false},
+		"PROFILES":                               {ProfilesKeyword, false},
+		"PROXY":                                  {ProxyKeyword, false},
+		"PURGE":                                  {PurgeKeyword, true},
+		"QUARTER":                                {QuarterKeyword, false},
+		"QUERY":                                  {QueryKeyword, false},
+		"QUICK":                                  {QuickKeyword, false},
+		"RANDOM":                                 {RandomKeyword, false},
+		"RANGE":                                  {RangeKeyword, true},
+		"RANK":                                   {RankKeyword, true},
+		"READ":                                   {ReadKeyword, true},
+		"READ_ONLY":                              {ReadOnlyKeyword, false},
+		"READ_WRITE":                             {ReadWriteKeyword, true},
+		"READS":                                  {ReadsKeyword, true},
+		"REAL":                                   {RealKeyword, true},
+		"REBUILD":                                {RebuildKeyword, false},
+		"RECOVER":                                {RecoverKeyword, false},
+		"RECURSIVE":                              {RecursiveKeyword, true},
+		"REDO_BUFFER_SIZE":                       {RedoBufferSizeKeyword, false},
+		"REDUNDANT":                              {RedundantKeyword, false},
+		"REFERENCE":                              {ReferenceKeyword, false},
+		"REFERENCES":                             {ReferencesKeyword, true},
+		"REGEXP":                                 {RegexpKeyword, true},
+		"REGISTRATION":                           {RegistrationKeyword, false},
+		"RELAY":                                  {RelayKeyword, false},
+		"RELAY_LOG_FILE":                         {RelayLogFileKeyword, false},
+		"RELAY_LOG_POS":                          {RelayLogPosKeyword, false},
+		"RELAY_THREAD":                           {RelayThreadKeyword, false},
+		"RELAYLOG":                               {RelaylogKeyword, false},
+		"RELEASE":                                {ReleaseKeyword, true},
+		"RELOAD":                                 {ReloadKeyword, false},
+		"REMOVE":                                 {RemoveKeyword, false},
+		"RENAME":                                 {RenameKeyword, true},
+		"REORGANIZE":                             {ReorganizeKeyword, false},
+		"REPAIR":                                 {RepairKeyword, false},
+		"REPEAT":                                 {RepeatKeyword, true},
+		"REPEATABLE":                             {RepeatableKeyword, false},
+		"REPLACE":                                {ReplaceKeyword, true},
+		"REPLICA":                                {ReplicaKeyword, false},
+		"REPLICAS":                               {ReplicasKeyword, false},
+		"REPLICATE_DO_DB":                        {ReplicateDoDbKeyword, false},
+		"REPLICATE_DO_TABLE":                     {ReplicateDoTableKeyword, false},
+		"REPLICATE_IGNORE_DB":                    {ReplicateIgnoreDbKeyword, false},
+		"REPLICATE_IGNORE_TABLE":                 {ReplicateIgnoreTableKeyword, false},
+		"REPLICATE_REWRITE_DB":                   {ReplicateRewriteDbKeyword, false},
+		"REPLICATE_WILD_DO_TABLE":                {ReplicateWildDoTableKeyword, false},
+		"REPLICATE_WILD_IGNORE_TABLE":            {ReplicateWildIgnoreTableKeyword, false},
+		"REPLICATION":                            {ReplicationKeyword, false},
+		"REQUIRE":                                {RequireKeyword, true},
+		"REQUIRE_ROW_FORMAT":                     {RequireRowFormatKeyword, false},
+		"REQUIRE_TABLE_PRIMARY_KEY_CHECK":        {RequireTablePrimaryKeyCheckKeyword, false},
+		"RESET":                                  {ResetKeyword, false},
+		"RESIGNAL":                               {ResignalKeyword, true},
+		"RESOURCE":                               {ResourceKeyword, false},
+		"RESPECT":                                {RespectKeyword, false},
+		"RESTART":                                {RestartKeyword, false},
+		"RESTORE":                                {RestoreKeyword, false},
+		"RESTRICT":                               {RestrictKeyword, true},
+		"RESUME":                                 {ResumeKeyword, false},
+		"RETAIN":                                 {RetainKeyword, false},
+		"RETURN":                                 {ReturnKeyword, true},
+		"RETURNED_SQLSTATE":                      {ReturnedSqlstateKeyword, false},
+		"RETURNING":                              {ReturningKeyword, false},
+		"RETURNS":                                {ReturnsKeyword, false},
+		"REUSE":                                  {ReuseKeyword, false},
+		"REVERSE":                                {ReverseKeyword, false},
+		"REVOKE":                                 {RevokeKeyword, true},
+		"RIGHT":                                  {RightKeyword, true},
+		"RLIKE":                                  {RlikeKeyword, true},
+		"ROLE":                                   {RoleKeyword, false},
+		"ROLLBACK":                               {RollbackKeyword, false},
+		"ROLLUP":                                 {RollupKeyword, false},
+		"ROTATE":                                 {RotateKeyword, false},
+		"ROUTINE":                                {RoutineKeyword, false},
+		"ROW":                                    {RowKeyword, true},
+		"ROW_COUNT":                              {RowCountKeyword, false},
+		"ROW_FORMAT":                             {RowFormatKeyword, false},
+		"ROW_NUMBER":                             {RowNumberKeyword, true},
+		"ROWS":                                   {RowsKeyword, true},
+		"RTREE":                                  {RtreeKeyword, false},
+		"SAVEPOINT":                              {SavepointKeyword, false},
+		"SCHEDULE":                               {ScheduleKeyword, false},
+		"SCHEMA":                                 {SchemaKeyword, true},
+		"SCHEMA_NAME":                            {SchemaNameKeyword, false},
+		"SCHEMAS":                                {SchemasKeyword, true},
+		"SECOND":                                 {SecondKeyword, false},
+		"SECOND_MICROSECOND":                     {SecondMicrosecondKeyword, true},
+		"SECONDARY":                              {SecondaryKeyword, false},
+		"SECONDARY_ENGINE":                       {SecondaryEngineKeyword, false},
+		"SECONDARY_ENGINE_ATTRIBUTE":             {SecondaryEngineAttributeKeyword, false},
+		"SECONDARY_LOAD":                         {SecondaryLoadKeyword, false},
+		"SECONDARY_UNLOAD":                       {SecondaryUnloadKeyword, false},
+		"SECURITY":                               {SecurityKeyword, false},
+		"SELECT":                                 {Select, true},
+		"SENSITIVE":                              {SensitiveKeyword, true},
+		"SEPARATOR":                              {SeparatorKeyword, true},
+		"SERIAL":                                 {SerialKeyword, false},
+		"SERIALIZABLE":                           {SerializableKeyword, false},
+		"SERVER":                                 {ServerKeyword, false},
+		"SESSION":                                {SessionKeyword, false},
+		"SET":                                    {SetKeyword, true},
+		"SHARE":                                  {ShareKeyword, false},
+		"SHOW":                                   {ShowKeyword, true},
+		"SHUTDOWN":                               {ShutdownKeyword, false},
+		"SIGNAL":                                 {SignalKeyword, true},
+		"SIGNED":                                 {SignedKeyword, false},
+		"SIMPLE":                                 {SimpleKeyword, false},
+		"SKIP":                                   {SkipKeyword, false},
+		"SLAVE":                                  {SlaveKeyword, false},
+		"SLOW":                                   {SlowKeyword, false},
+		"SMALLINT":                               {SmallintKeyword, true},
+		"SNAPSHOT":                               {SnapshotKeyword, false},
+		"SOCKET":                                 {SocketKeyword, false},
+		"SOME":                                   {SomeKeyword, false},
+		"SONAME":                                 {SonameKeyword, false},
+		"SOUNDS":                                 {SoundsKeyword, false},
+		"SOURCE":                                 {SourceKeyword, false},
+		"SOURCE_AUTO_POSITION":                   {SourceAutoPositionKeyword, false},
+		"SOURCE_BIND":                            {SourceBindKeyword, false},
+		"SOURCE_COMPRESSION_ALGORITHMS":          {SourceCompressionAlgorithmsKeyword, false},
+		"SOURCE_CONNECT_RETRY":                   {SourceConnectRetryKeyword, false},
+		"SOURCE_CONNECTION_AUTO_FAILOVER":        {SourceConnectionAutoFailoverKeyword, false},
+		"SOURCE_DELAY":                           {SourceDelayKeyword, false},
+		"SOURCE_HEARTBEAT_PERIOD":                {SourceHeartbeatPeriodKeyword, false},
+		"SOURCE_HOST":                            {SourceHostKeyword, false},
+		"SOURCE_LOG_FILE":                        {SourceLogFileKeyword, false},
+		"SOURCE_LOG_POS":                         {SourceLogPosKeyword, false},
+		"SOURCE_PASSWORD":                        {SourcePasswordKeyword, false},
+		"SOURCE_PORT":                            {SourcePortKeyword, false},
+		"SOURCE_PUBLIC_KEY_PATH":                 {SourcePublicKeyPathKeyword, false},
+		"SOURCE_RETRY_COUNT":                     {SourceRetryCountKeyword, false},
+		"SOURCE_SSL":                             {SourceSslKeyword, false},
+		"SOURCE_SSL_CA":                          {SourceSslCaKeyword, false},
+		"SOURCE_SSL_CAPATH":                      {SourceSslCapathKeyword, false},
+		"SOURCE_SSL_CERT":                        {SourceSslCertKeyword, false},
+		"SOURCE_SSL_CIPHER":                      {SourceSslCipherKeyword, false},
+		"SOURCE_SSL_CRL":                         {SourceSslCrlKeyword, false},
+		"SOURCE_SSL_CRLPATH":                     {SourceSslCrlpathKeyword, false},
+		"SOURCE_SSL_KEY":                         {SourceSslKeyKeyword, false},
+		"SOURCE_SSL_VERIFY_SERVER_CERT":          {SourceSslVerifyServerCertKeyword, false},
+		"SOURCE_TLS_CIPHERSUITES":                {SourceTlsCiphersuitesKeyword, false},
+		"SOURCE_TLS_VERSION":                     {SourceTlsVersionKeyword, false},
+		"SOURCE_USER":                            {SourceUserKeyword, false},
+		"SOURCE_ZSTD_COMPRESSION_LEVEL":          {SourceZstdCompressionLevelKeyword, false},
+		"SPATIAL":                                {SpatialKeyword, true},
+		"SPECIFIC":                               {SpecificKeyword, true},
+		"SQL":                                    {SqlKeyword, true},
+		"SQL_AFTER_GTIDS":                        {SqlAfterGtidsKeyword, false},
+		"SQL_AFTER_MTS_GAPS":                     {SqlAfterMtsGapsKeyword, false},
+		"SQL_BEFORE_GTIDS":                       {SqlBeforeGtidsKeyword, false},
+		"SQL_BIG_RESULT":                         {SqlBigResultKeyword, true},
+		"SQL_BUFFER_RESULT":                      {SqlBufferResultKeyword, false},
+		"SQL_CALC_FOUND_ROWS":                    {SqlCalcFoundRowsKeyword, true},
+		"SQL_NO_CACHE":                           {SqlNoCacheKeyword, false},
+		"SQL_SMALL_RESULT":                       {SqlSmallResultKeyword, true},
+		"SQL_THREAD":                             {SqlThreadKeyword, false},
+		"SQL_TSI_DAY":                            {SqlTsiDayKeyword, false},
+		"SQL_TSI_HOUR":                           {SqlTsiHourKeyword, false},
+		"SQL_TSI_MINUTE":                         {SqlTsiMinuteKeyword, false},
+		"SQL_TSI_MONTH":                          {SqlTsiMonthKeyword, false},
+		"SQL_TSI_QUARTER":                        {SqlTsiQuarterKeyword, false},
+		"SQL_TSI_SECOND":                         {SqlTsiSecondKeyword, false},
+		"SQL_TSI_WEEK":                           {SqlTsiWeekKeyword, false},
+		"SQL_TSI_YEAR":                           {SqlTsiYearKeyword, false},
+		"SQLEXCEPTION":                           {SqlexceptionKeyword, true},
+		"SQLSTATE":                               {SqlstateKeyword, true},
+		"SQLWARNING":                             {SqlwarningKeyword, true},
+		"SRID":                                   {SridKeyword, false},
+		"SSL":                                    {SslKeyword, true},
+		"STACKED":                                {StackedKeyword, false},
+		"START":                                  {StartKeyword, false},
+		"STARTING":                               {StartingKeyword, true},
+		"STARTS":                                 {StartsKeyword, false},
+		"STATS_AUTO_RECALC":                      {StatsAutoRecalcKeyword, false},
+		"STATS_PERSISTENT":                       {StatsPersistentKeyword, false},
+		"STATS_SAMPLE_PAGES":                     {StatsSamplePagesKeyword, false},
+		"STATUS":                                 {StatusKeyword, false},
+		"STOP":                                   {StopKeyword, false},
+		"STORAGE":                                {StorageKeyword, false},
+		"STORED":                                 {StoredKeyword, true},
+		"STRAIGHT_JOIN":                          {StraightJoinKeyword, true},
+		"STREAM":                                 {StreamKeyword, false},
+		"STRING":                                 {StringKeyword, false},
+		"SUBCLASS_ORIGIN":                        {SubclassOriginKeyword, false},
+		"SUBJECT":                                {SubjectKeyword, false},
+		"SUBPARTITION":                           {SubpartitionKeyword, false},
+		"SUBPARTITIONS":                          {SubpartitionsKeyword, false},
+		"SUPER":                                  {SuperKeyword, false},
+		"SUSPEND":                                {SuspendKeyword, false},
+		"SWAPS":                                  {SwapsKeyword, false},
+		"SWITCHES":                               {SwitchesKeyword, false},
+		"SYSTEM":                                 {SystemKeyword, true},
+		"TABLE":                                  {TableKeyword, true},
+		"TABLE_CHECKSUM":                         {TableChecksumKeyword, false},
+		"TABLE_NAME":                             {TableNameKeyword, false},
+		"TABLES":                                 {TablesKeyword, false},
+		"TABLESPACE":                             {TablespaceKeyword, false},
+		"TEMPORARY":                              {TemporaryKeyword, false},
+		"TEMPTABLE":                              {TemptableKeyword, false},
+		"TERMINATED":                             {TerminatedKeyword, true},
+		"TEXT":                                   {TextKeyword, false},
+		"THAN":                                   {ThanKeyword, false},
+		"THEN":                                   {ThenKeyword, true},
+		"THREAD_PRIORITY":                        {ThreadPriorityKeyword, false},
+		"TIES":                                   {TiesKeyword, false},
+		"TIME":                                   {TimeKeyword, false},
+		"TIMESTAMP":                              {TimestampKeyword, false},
+		"TIMESTAMPADD":                           {TimestampaddKeyword, false},
+		"TIMESTAMPDIFF":                          {TimestampdiffKeyword, false},
+		"TINYBLOB":                               {TinyblobKeyword, true},
+		"TINYINT":                                {TinyintKeyword, true},
+		"TINYTEXT":                               {TinytextKeyword, true},
+		"TLS":                                    {TlsKeyword, false},
+		"TO":                                     {ToKeyword, true},
+		"TRAILING":                               {TrailingKeyword, true},
+		"TRANSACTION":                            {TransactionKeyword, false},
+		"TRIGGER":                                {TriggerKeyword, true},
+		"TRIGGERS":                               {TriggersKeyword, false},
+		"TRUE":                                   {TrueKeyword, true},
+		"TRUNCATE":                               {TruncateKeyword, false},
+		"TYPE":                                   {TypeKeyword, false},
+		"TYPES":                                  {TypesKeyword, false},
+		"UNBOUNDED":                              {UnboundedKeyword, false},
+		"UNCOMMITTED":                            {UncommittedKeyword, false},
+		"UNDEFINED":                              {UndefinedKeyword, false},
+		"UNDO":                                   {UndoKeyword, true},
+		"UNDO_BUFFER_SIZE":                       {UndoBufferSizeKeyword, false},
+		"UNDOFILE":                               {UndofileKeyword, false},
+		"UNICODE":                                {UnicodeKeyword, false},
+		"UNINSTALL":                              {UninstallKeyword, false},
+		"UNION":                                  {UnionKeyword, true},
+		"UNIQUE":                                 {UniqueKeyword, true},
+		"UNKNOWN":                                {UnknownKeyword, false},
+		"UNLOCK":                                 {UnlockKeyword, true},
+		"UNREGISTER":                             {UnregisterKeyword, false},
+		"UNSIGNED":                               {UnsignedKeyword, true},
+		"UNTIL":                                  {UntilKeyword, false},
+		"UPDATE":                                 {UpdateKeyword, true},
+		"UPGRADE":                                {UpgradeKeyword, false},
+		"USAGE":                                  {UsageKeyword, true},
+		"USE":                                    {UseKeyword, true},
+		"USE_FRM":                                {UseFrmKeyword, false},
+		"USER":                                   {UserKeyword, false},
+		"USER_RESOURCES":                         {UserResourcesKeyword, false},
+		"USING":                                  {UsingKeyword, true},
+		"UTC_DATE":                               {UtcDateKeyword, true},
+		"UTC_TIME":                               {UtcTimeKeyword, true},
+		"UTC_TIMESTAMP":                          {UtcTimestampKeyword, true},
+		"VALIDATION":                             {ValidationKeyword, false},
+		"VALUE":                                  {ValueKeyword, false},
+		"VALUES":                                 {ValuesKeyword, true},
+		"VARBINARY":                              {VarbinaryKeyword, true},
+		"VARCHAR":                                {VarcharKeyword, true},
+		"VARCHARACTER":                           {VarcharacterKeyword, true},
+		"VARIABLES":                              {VariablesKeyword, false},
+		"VARYING":                                {VaryingKeyword, true},
+		"VCPU":                                   {VcpuKeyword, false},
+		"VIEW":                                   {ViewKeyword, false},
+		"VIRTUAL":                                {VirtualKeyword, true},
+		"VISIBLE":                                {VisibleKeyword, false},
+		"WAIT":                                   {WaitKeyword, false},
+		"WARNINGS":                               {WarningsKeyword, false},
+		"WEEK":                                   {WeekKeyword, false},
+		"WEIGHT_STRING":                          {WeightStringKeyword, false},
+		"WHEN":                                   {WhenKeyword, true},
+		"WHERE":                                  {Where, true},
+		"WHILE":                                  {WhileKeyword, true},
+		"WINDOW":                                 {WindowKeyword, true},
+		"WITH":                                   {WithKeyword, true},
+		"WITHOUT":                                {WithoutKeyword, false},
+		"WORK":                                   {WorkKeyword, false},
+		"WRAPPER":                                {WrapperKeyword, false},
+		"WRITE":                                  {WriteKeyword, true},
+		"X509":                                   {X509Keyword, false},
+		"XA":                                     {XaKeyword, false},
+		"XID":                                    {XidKeyword, false},
+		"XML":                                    {XmlKeyword, false},
+		"XOR":                                    {XorKeyword, true},
+		"YEAR":                                   {YearKeyword, false},
+		"YEAR_MONTH":                             {YearMonthKeyword, true},
+		"ZEROFILL":                               {ZerofillKeyword, true},
+		"ZONE":                                   {ZoneKeyword, false},
+	}
+	// keywordStringMap is the map of the keyword token type and the upper case keyword
+	keywordStringMap = map[Type]string{
+		AccessibleKeyword: "ACCESSIBLE",
+		AccountKeyword:    "ACCOUNT",
+		ActionKeyword:     "ACTION",
+		ActiveKeyword:     "ACTIVE",
+		AddKeyword:        "ADD",
+		AdminKeyword:      "ADMIN",
+		AfterKeyword:      "AFTER",
+		AgainstKeyword:    "AGAINST",
+		AggregateKeyword:  "AGGREGATE",
+		AlgorithmKeyword:  "ALGORITHM",
+		AllKeyword:        "ALL",
+		AlterKeyword:      "ALTER",
+		AlwaysKeyword:     "ALWAYS",
+		AnalyzeKeyword:    "ANALYZE",
+		And:               "AND",
+		AnyKeyword:        "ANY",
+		ArrayKeyword:      "ARRAY",
+		As:                "AS",
+		AscKeyword:        "ASC",
+		AsciiKeyword:      "ASCII",
+		AsensitiveKeyword: "ASENSITIVE",
+		AssignGtidsToAnonymousTransactionsKeyword: "ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS",
+		AtKeyword:                           "AT",
+		AttributeKeyword:                    "ATTRIBUTE",
+		AuthenticationKeyword:               "AUTHENTICATION",
+		AutoIncrementKeyword:                "AUTO_INCREMENT",
+		AutoextendSizeKeyword:               "AUTOEXTEND_SIZE",
+		AvgKeyword:                          "AVG",
+		AvgRowLengthKeyword:                 "AVG_ROW_LENGTH",
+		BackupKeyword:                       "BACKUP",
+		BeforeKeyword:                       "BEFORE",
+		BeginKeyword:                        "BEGIN",
+		BetweenKeyword:                      "BETWEEN",
+		BigintKeyword:                       "BIGINT",
+		BinaryKeyword:                       "BINARY",
+		BinlogKeyword:                       "BINLOG",
+		BitKeyword:                          "BIT",
+		BlobKeyword:                         "BLOB",
+		BlockKeyword:                        "BLOCK",
+		BoolKeyword:                         "BOOL",
+		BooleanKeyword:                      "BOOLEAN",
+		BothKeyword:                         "BOTH",
+		BtreeKeyword:                        "BTREE",
+		BucketsKeyword:                      "BUCKETS",
+		ByKeyword:                           "BY",
+		ByteKeyword:                         "BYTE",
+		CacheKeyword:                        "CACHE",
+		CallKeyword:                         "CALL",
+		CascadeKeyword:                      "CASCADE",
+		CascadedKeyword:                     "CASCADED",
+		CaseKeyword:                         "CASE",
+		CatalogNameKeyword:                  "CATALOG_NAME",
+		ChainKeyword:                        "CHAIN",
+		ChallengeResponseKeyword:            "CHALLENGE_RESPONSE",
+		ChangeKeyword:                       "CHANGE",
+		ChangedKeyword:                      "CHANGED",
+		ChannelKeyword:                      "CHANNEL",
+		CharKeyword:                         "CHAR",
+		CharacterKeyword:                    "CHARACTER",
+		CharsetKeyword:                      "CHARSET",
+		CheckKeyword:                        "CHECK",
+		ChecksumKeyword:                     "CHECKSUM",
+		CipherKeyword:                       "CIPHER",
+		ClassOriginKeyword:                  "CLASS_ORIGIN",
+		ClientKeyword:                       "CLIENT",
+		CloneKeyword:                        "CLONE",
+		CloseKeyword:                        "CLOSE",
+		CoalesceKeyword:                     "COALESCE",
+		CodeKeyword:                         "CODE",
+		CollateKeyword:                      "COLLATE",
+		CollationKeyword:                    "COLLATION",
+		ColumnKeyword:                       "COLUMN",
+		ColumnFormatKeyword:                 "COLUMN_FORMAT",
+		ColumnNameKeyword:                   "COLUMN_NAME",
+		ColumnsKeyword:                      "COLUMNS",
+		CommentKeyword:                      "COMMENT",
+		CommitKeyword:                       "COMMIT",
+		CommittedKeyword:                    "COMMITTED",
+		CompactKeyword:                      "COMPACT",
+		CompletionKeyword:                   "COMPLETION",
+		ComponentKeyword:                    "COMPONENT",
+		CompressedKeyword:                   "COMPRESSED",
+		CompressionKeyword:                  "COMPRESSION",
+		ConcurrentKeyword:                   "CONCURRENT",
+		ConditionKeyword:                    "CONDITION",
+		ConnectionKeyword:                   "CONNECTION",
+		ConsistentKeyword:                   "CONSISTENT",
+		ConstraintKeyword:                   "CONSTRAINT",
+		ConstraintCatalogKeyword:            "CONSTRAINT_CATALOG",
+		ConstraintNameKeyword:               "CONSTRAINT_NAME",
+		ConstraintSchemaKeyword:             "CONSTRAINT_SCHEMA",
+		ContainsKeyword:                     "CONTAINS",
+		ContextKeyword:                      "CONTEXT",
+		ContinueKeyword:                     "CONTINUE",
+		ConvertKeyword:                      "CONVERT",
+		CpuKeyword:                          "CPU",
+		CreateKeyword:                       "CREATE",
+		CrossKeyword:                        "CROSS",
+		CubeKeyword:                         "CUBE",
+		CumeDistKeyword:                     "CUME_DIST",
+		CurrentKeyword:                      "CURRENT",
+		CurrentDateKeyword:                  "CURRENT_DATE",
+		CurrentTimeKeyword:                  "CURRENT_TIME",
+		CurrentTimestampKeyword:             "CURRENT_TIMESTAMP",
+		CurrentUserKeyword:                  "CURRENT_USER",
+		CursorKeyword:                       "CURSOR",
+		CursorNameKeyword:                   "CURSOR_NAME",
+		DataKeyword:                         "DATA",
+		DatabaseKeyword:                     "DATABASE",
+		DatabasesKeyword:                    "DATABASES",
+		DatafileKeyword:                     "DATAFILE",
+		DateKeyword:                         "DATE",
+		DatetimeKeyword:                     "DATETIME",
+		DayKeyword:                          "DAY",
+		DayHourKeyword:                      "DAY_HOUR",
+		DayMicrosecondKeyword:               "DAY_MICROSECOND",
+		DayMinuteKeyword:                    "DAY_MINUTE",
+		DaySecondKeyword:                    "DAY_SECOND",
+		DeallocateKeyword:                   "DEALLOCATE",
+		DecKeyword:                          "DEC",
+		DecimalKeyword:                      "DECIMAL",
+		DeclareKeyword:                      "DECLARE",
+		DefaultKeyword:                      "DEFAULT",
+		DefaultAuthKeyword:                  "DEFAULT_AUTH",
+		DefinerKeyword:                      "DEFINER",
+		DefinitionKeyword:                   "DEFINITION",
+		DelayKeyWriteKeyword:                "DELAY_KEY_WRITE",
+		DelayedKeyword:                      "DELAYED",
+		DeleteKeyword:                       "DELETE",
+		DenseRankKeyword:                    "DENSE_RANK",
+		DescKeyword:                         "DESC",
+		DescribeKeyword:                     "DESCRIBE",
+		DescriptionKeyword:                  "DESCRIPTION",
+		DeterministicKeyword:                "DETERMINISTIC",
+		DiagnosticsKeyword:                  "DIAGNOSTICS",
+		DirectoryKeyword:                    "DIRECTORY",
+		DisableKeyword:                      "DISABLE",
+		DiscardKeyword:                      "DISCARD",
+		DiskKeyword:                         "DISK",
+		DistinctKeyword:                     "DISTINCT",
+		DistinctrowKeyword:                  "DISTINCTROW",
+		DivKeyword:                          "DIV",
+		DoKeyword:                           "DO",
+		DoubleKeyword:                       "DOUBLE",
+		DropKeyword:                         "DROP",
+		DualKeyword:                         "DUAL",
+		DumpfileKeyword:                     "DUMPFILE",
+		DuplicateKeyword:                    "DUPLICATE",
+		DynamicKeyword:                      "DYNAMIC",
+		EachKeyword:                         "EACH",
+		ElseKeyword:                         "ELSE",
+		ElseifKeyword:                       "ELSEIF",
+		EmptyKeyword:                        "EMPTY",
+		EnableKeyword:                       "ENABLE",
+		EnclosedKeyword:                     "ENCLOSED",
+		EncryptionKeyword:                   "ENCRYPTION",
+		EndKeyword:                          "END",
+		EndsKeyword:                         "ENDS",
+		EnforcedKeyword:                     "ENFORCED",
+		EngineKeyword:                       "ENGINE",
+		EngineAttributeKeyword:              "ENGINE_ATTRIBUTE",
+		EnginesKeyword:                      "ENGINES",
+		EnumKeyword:                         "ENUM",
+		ErrorKeyword:                        "ERROR",
+		ErrorsKeyword:                       "ERRORS",
+		EscapeKeyword:                       "ESCAPE",
+		EscapedKeyword:                      "ESCAPED",
+		EventKeyword:                        "EVENT",
+		EventsKeyword:                       "EVENTS",
+		EveryKeyword:                        "EVERY",
+		ExceptKeyword:                       "EXCEPT",
+		ExchangeKeyword:                     "EXCHANGE",
+		ExcludeKeyword:                      "EXCLUDE",
+		ExecuteKeyword:                      "EXECUTE",
+		ExistsKeyword:                       "EXISTS",
+		ExitKeyword:                         "EXIT",
+		ExpansionKeyword:                    "EXPANSION",
+		ExpireKeyword:                       "EXPIRE",
+		ExplainKeyword:                      "EXPLAIN",
+		ExportKeyword:                       "EXPORT",
+		ExtendedKeyword:                     "EXTENDED",
+		ExtentSizeKeyword:                   "EXTENT_SIZE",
+		FactorKeyword:                       "FACTOR",
+		FailedLoginAttemptsKeyword:          "FAILED_LOGIN_ATTEMPTS",
+		FalseKeyword:                        "FALSE",
+		FastKeyword:                         "FAST",
+		FaultsKeyword:                       "FAULTS",
+		FetchKeyword:                        "FETCH",
+		FieldsKeyword:                       "FIELDS",
+		FileKeyword:                         "FILE",
+		FileBlockSizeKeyword:                "FILE_BLOCK_SIZE",
+		FilterKeyword:                       "FILTER",
+		FinishKeyword:                       "FINISH",
+		FirstKeyword:                        "FIRST",
+		FirstValueKeyword:                   "FIRST_VALUE",
+		FixedKeyword:                        "FIXED",
+		FloatKeyword:                        "FLOAT",
+		Float4Keyword:                       "FLOAT4",
+		Float8Keyword:                       "FLOAT8",
+		FlushKeyword:                        "FLUSH",
+		FollowingKeyword:                    "FOLLOWING",
+		FollowsKeyword:                      "FOLLOWS",
+		ForKeyword:                          "FOR",
+		ForceKeyword:                        "FORCE",
+		ForeignKeyword:                      "FOREIGN",
+		FormatKeyword:                       "FORMAT",
+		FoundKeyword:                        "FOUND",
+		From:                                "FROM",
+		FullKeyword:                         "FULL",
+		FulltextKeyword:                     "FULLTEXT",
+		FunctionKeyword:                     "FUNCTION",
+		GeneralKeyword:                      "GENERAL",
+		GeneratedKeyword:                    "GENERATED",
+		GeomcollectionKeyword:               "GEOMCOLLECTION",
+		GeometryKeyword:                     "GEOMETRY",
+		GeometrycollectionKeyword:           "GEOMETRYCOLLECTION",
+		GetKeyword:                          "GET",
+		GetFormatKeyword:                    "GET_FORMAT",
+		GetMasterPublicKeyKeyword:           "GET_MASTER_PUBLIC_KEY",
+		GetSourcePublicKeyKeyword:           "GET_SOURCE_PUBLIC_KEY",
+		GlobalKeyword:                       "GLOBAL",
+		GrantKeyword:                        "GRANT",
+		GrantsKeyword:                       "GRANTS",
+		GroupKeyword:                        "GROUP",
+		GroupReplicationKeyword:             "GROUP_REPLICATION",
+		GroupingKeyword:                     "GROUPING",
+		GroupsKeyword:                       "GROUPS",
+		GtidOnlyKeyword:                     "GTID_ONLY",
+		HandlerKeyword:                      "HANDLER",
+		HashKeyword:                         "HASH",
+		HavingKeyword:                       "HAVING",
+		HelpKeyword:                         "HELP",
+		HighPriorityKeyword:                 "HIGH_PRIORITY",
+		HistogramKeyword:                    "HISTOGRAM",
+		HistoryKeyword:                      "HISTORY",
+		HostKeyword:                         "HOST",
+		HostsKeyword:                        "HOSTS",
+		HourKeyword:                         "HOUR",
+		HourMicrosecondKeyword:              "HOUR_MICROSECOND",
+		HourMinuteKeyword:                   "HOUR_MINUTE",
+		HourSecondKeyword:                   "HOUR_SECOND",
+		IdentifiedKeyword:                   "IDENTIFIED",
+		IfKeyword:                           "IF",
+		IgnoreKeyword:                       "IGNORE",
+		IgnoreServerIdsKeyword:              "IGNORE_SERVER_IDS",
+		ImportKeyword:                       "IMPORT",
+		InKeyword:                           "IN",
+		InactiveKeyword:                     "INACTIVE",
+		IndexKeyword:                        "INDEX",
+		IndexesKeyword:                      "INDEXES",
+		InfileKeyword:                       "INFILE",
+		InitialKeyword:                      "INITIAL",
+		InitialSizeKeyword:                  "INITIAL_SIZE",
+		InitiateKeyword:                     "INITIATE",
+		InnerKeyword:                        "INNER",
+		InoutKeyword:                        "INOUT",
+		InsensitiveKeyword:                  "INSENSITIVE",
+		InsertKeyword:                       "INSERT",
+		InsertMethodKeyword:                 "INSERT_METHOD",
+		InstallKeyword:                      "INSTALL",
+		InstanceKeyword:                     "INSTANCE",
+		IntKeyword:                          "INT",
+		Int1Keyword:                         "INT1",
+		Int2Keyword:                         "INT2",
+		Int3Keyword:                         "INT3",
+		Int4Keyword:                         "INT4",
+		Int8Keyword:                         "INT8",
+		IntegerKeyword:                      "INTEGER",
+		IntervalKeyword:                     "INTERVAL",
+		IntoKeyword:                         "INTO",
+		InvisibleKeyword:                    "INVISIBLE",
+		InvokerKeyword:                      "INVOKER",
+		IoKeyword:                           "IO",
+		IoAfterGtidsKeyword:                 "IO_AFTER_GTIDS",
+		IoBeforeGtidsKeyword:                "IO_BEFORE_GTIDS",
+		IoThreadKeyword:                     "IO_THREAD",
+		IpcKeyword:                          "IPC",
+		IsKeyword:                           "IS",
+		IsolationKeyword:                    "ISOLATION",
+		IssuerKeyword:                       "ISSUER",
+		IterateKeyword:                      "ITERATE",
+		JoinKeyword:                         "JOIN",
+		JsonKeyword:                         "JSON",
+		JsonTableKeyword:                    "JSON_TABLE",
+		JsonValueKeyword:                    "JSON_VALUE",
+		KeyKeyword:                          "KEY",
+		KeyBlockSizeKeyword:                 "KEY_BLOCK_SIZE",
+		KeyringKeyword:                      "KEYRING",
+		KeysKeyword:                         "KEYS",
+		KillKeyword:                         "KILL",
+		LagKeyword:                          "LAG",
+		LanguageKeyword:                     "LANGUAGE",
+		LastKeyword:                         "LAST",
+		LastValueKeyword:                    "LAST_VALUE",
+		LateralKeyword:                      "LATERAL",
+		LeadKeyword:                         "LEAD",
+		LeadingKeyword:                      "LEADING",
+		LeaveKeyword:                        "LEAVE",
+		LeavesKeyword:                       "LEAVES",
+		LeftKeyword:                         "LEFT",
+		LessKeyword:                         "LESS",
+		LevelKeyword:                        "LEVEL",
+		LikeKeyword:                         "LIKE",
+		LimitKeyword:                        "LIMIT",
+		LinearKeyword:                       "LINEAR",
+		LinesKeyword:                        "LINES",
+		LinestringKeyword:                   "LINESTRING",
+		ListKeyword:                         "LIST",
+		LoadKeyword:                         "LOAD",
+		LocalKeyword:                        "LOCAL",
+		LocaltimeKeyword:                    "LOCALTIME",
+		LocaltimestampKeyword:               "LOCALTIMESTAMP",
+		LockKeyword:                         "LOCK",
+		LockedKeyword:                       "LOCKED",
+		LocksKeyword:                        "LOCKS",
+		LogfileKeyword:                      "LOGFILE",
+		LogsKeyword:                         "LOGS",
+		LongKeyword:                         "LONG",
+		LongblobKeyword:                     "LONGBLOB",
+		LongtextKeyword:                     "LONGTEXT",
+		LoopKeyword:                         "LOOP",
+		LowPriorityKeyword:                  "LOW_PRIORITY",
+		MasterKeyword:                       "MASTER",
+		MasterAutoPositionKeyword:           "MASTER_AUTO_POSITION",
+		MasterBindKeyword:                   "MASTER_BIND",
+		MasterCompressionAlgorithmsKeyword:  "MASTER_COMPRESSION_ALGORITHMS",
+		MasterConnectRetryKeyword:           "MASTER_CONNECT_RETRY",
+		MasterDelayKeyword:                  "MASTER_DELAY",
+		MasterHeartbeatPeriodKeyword:        "MASTER_HEARTBEAT_PERIOD",
+		MasterHostKeyword:                   "MASTER_HOST",
+		MasterLogFileKeyword:                "MASTER_LOG_FILE",
+		MasterLogPosKeyword:                 "MASTER_LOG_POS",
+		MasterPasswordKeyword:               "MASTER_PASSWORD",
+		MasterPortKeyword:                   "MASTER_PORT",
+		MasterPublicKeyPathKeyword:          "MASTER_PUBLIC_KEY_PATH",
+		MasterRetryCountKeyword:             "MASTER_RETRY_COUNT",
+		MasterSslKeyword:                    "MASTER_SSL",
+		MasterSslCaKeyword:                  "MASTER_SSL_CA",
+		MasterSslCapathKeyword:              "MASTER_SSL_CAPATH",
+		MasterSslCertKeyword:                "MASTER_SSL_CERT",
+		MasterSslCipherKeyword:              "MASTER_SSL_CIPHER",
+		MasterSslCrlKeyword:                 "MASTER_SSL_CRL",
+		MasterSslCrlpathKeyword:             "MASTER_SSL_CRLPATH",
+		MasterSslKeyKeyword:                 "MASTER_SSL_KEY",
+		MasterSslVerifyServerCertKeyword:    "MASTER_SSL_VERIFY_SERVER_CERT",
+		MasterTlsCiphersuitesKeyword:        "MASTER_TLS_CIPHERSUITES",
+		MasterTlsVersionKeyword:             "MASTER_TLS_VERSION",
+		MasterUserKeyword:                   "MASTER_USER",
+		MasterZstdCompressionLevelKeyword:   "MASTER_ZSTD_COMPRESSION_LEVEL",
+		MatchKeyword:                        "MATCH",
+		MaxConnectionsPerHourKeyword:        "MAX_CONNECTIONS_PER_HOUR",
+		MaxQueriesPerHourKeyword:            "MAX_QUERIES_PER_HOUR",
+		MaxRowsKeyword:                      "MAX_ROWS",
+		MaxSizeKeyword:                      "MAX_SIZE",
+		MaxUpdatesPerHourKeyword:            "MAX_UPDATES_PER_HOUR",
+		MaxUserConnectionsKeyword:           "MAX_USER_CONNECTIONS",
+		MaxvalueKeyword:                     "MAXVALUE",
+		MediumKeyword:                       "MEDIUM",
+		MediumblobKeyword:                   "MEDIUMBLOB",
+		MediumintKeyword:                    "MEDIUMINT",
+		MediumtextKeyword:                   "MEDIUMTEXT",
+		MemberKeyword:                       "MEMBER",
+		MemoryKeyword:                       "MEMORY",
+		MergeKeyword:                        "MERGE",
+		MessageTextKeyword:                  "MESSAGE_TEXT",
+		MicrosecondKeyword:                  "MICROSECOND",
+		MiddleintKeyword:                    "MIDDLEINT",
+		MigrateKeyword:                      "MIGRATE",
+		MinRowsKeyword:                      "MIN_ROWS",
+		MinuteKeyword:                       "MINUTE",
+		MinuteMicrosecondKeyword:            "MINUTE_MICROSECOND",
+		MinuteSecondKeyword:                 "MINUTE_SECOND",
+		ModKeyword:                          "MOD",
+		ModeKeyword:                         "MODE",
+		ModifiesKeyword:                     "MODIFIES",
+		ModifyKeyword:                       "MODIFY",
+		MonthKeyword:                        "MONTH",
+		MultilinestringKeyword:              "MULTILINESTRING",
+		MultipointKeyword:                   "MULTIPOINT",
+		MultipolygonKeyword:                 "MULTIPOLYGON",
+		MutexKeyword:                        "MUTEX",
+		MysqlErrnoKeyword:                   "MYSQL_ERRNO",
+		NameKeyword:                         "NAME",
+		NamesKeyword:                        "NAMES",
+		NationalKeyword:                     "NATIONAL",
+		NaturalKeyword:                      "NATURAL",
+		NcharKeyword:                        "NCHAR",
+		NdbKeyword:                          "NDB",
+		NdbclusterKeyword:                   "NDBCLUSTER",
+		NestedKeyword:                       "NESTED",
+		NetworkNamespaceKeyword:             "NETWORK_NAMESPACE",
+		NeverKeyword:                        "NEVER",
+		NewKeyword:                          "NEW",
+		NextKeyword:                         "NEXT",
+		NoKeyword:                           "NO",
+		NoWaitKeyword:                       "NO_WAIT",
+		NoWriteToBinlogKeyword:              "NO_WRITE_TO_BINLOG",
+		NodegroupKeyword:                    "NODEGROUP",
+		NoneKeyword:                         "NONE",
+		NotKeyword:                          "NOT",
+		NowaitKeyword:                       "NOWAIT",
+		NthValueKeyword:                     "NTH_VALUE",
+		NtileKeyword:                        "NTILE",
+		NullKeyword:                         "NULL",
+		NullsKeyword:                        "NULLS",
+		NumberKeyword:                       "NUMBER",
+		NumericKeyword:                      "NUMERIC",
+		NvarcharKeyword:                     "NVARCHAR",
+		OfKeyword:                           "OF",
+		OffKeyword:                          "OFF",
+		OffsetKeyword:                       "OFFSET",
+		OjKeyword:                           "OJ",
+		OldKeyword:                          "OLD",
+		OnKeyword:                           "ON",
+		OneKeyword:                          "ONE",
+		OnlyKeyword:                         "ONLY",
+		OpenKeyword:                         "OPEN",
+		OptimizeKeyword:                     "OPTIMIZE",
+		OptimizerCostsKeyword:               "OPTIMIZER_COSTS",
+		OptionKeyword:                       "OPTION",
+		OptionalKeyword:                     "OPTIONAL",
+		OptionallyKeyword:                   "OPTIONALLY",
+		OptionsKeyword:                      "OPTIONS",
+		Or:                                  "OR",
+		OrderKeyword:                        "ORDER",
+		OrdinalityKeyword:                   "ORDINALITY",
+		OrganizationKeyword:                 "ORGANIZATION",
+		OthersKeyword:                       "OTHERS",
+		OutKeyword:                          "OUT",
+		OuterKeyword:                        "OUTER",
+		OutfileKeyword:                      "OUTFILE",
+		OverKeyword:                         "OVER",
+		OwnerKeyword:                        "OWNER",
+		PackKeysKeyword:                     "PACK_KEYS",
+		PageKeyword:                         "PAGE",
+		ParserKeyword:                       "PARSER",
+		PartialKeyword:                      "PARTIAL",
+		PartitionKeyword:                    "PARTITION",
+		PartitioningKeyword:                 "PARTITIONING",
+		PartitionsKeyword:                   "PARTITIONS",
+		PasswordKeyword:                     "PASSWORD",
+		PasswordLockTimeKeyword:             "PASSWORD_LOCK_TIME",
+		PathKeyword:                         "PATH",
+		PercentRankKeyword:                  "PERCENT_RANK",
+		PersistKeyword:                      "PERSIST",
+		PersistOnlyKeyword:                  "PERSIST_ONLY",
+		PhaseKeyword:                        "PHASE",
+		PluginKeyword:                       "PLUGIN",
+		PluginDirKeyword:                    "PLUGIN_DIR",
+		PluginsKeyword:                      "PLUGINS",
+		PointKeyword:                        "POINT",
+		PolygonKeyword:                      "POLYGON",
+		PortKeyword:                         "PORT",
+		PrecedesKeyword:                     "PRECEDES",
+		PrecedingKeyword:                    "PRECEDING",
+		PrecisionKeyword:                    "PRECISION",
+		PrepareKeyword:                      "PREPARE",
+		PreserveKeyword:                     "PRESERVE",
+		PrevKeyword:                         "PREV",
+		PrimaryKeyword:                      "PRIMARY",
+		PrivilegeChecksUserKeyword:          "PRIVILEGE_CHECKS_USER",
+		PrivilegesKeyword:                   "PRIVILEGES",
+		ProcedureKeyword:                    "PROCEDURE",
+		ProcessKeyword:                      "PROCESS",
+		ProcesslistKeyword:                  "PROCESSLIST",
+		ProfileKeyword:                      "PROFILE",
+		ProfilesKeyword:                     "PROFILES",
+		ProxyKeyword:                        "PROXY",
+		PurgeKeyword:                        "PURGE",
+		QuarterKeyword:                      "QUARTER",
+		QueryKeyword:                        "QUERY",
+		QuickKeyword:                        "QUICK",
+		RandomKeyword:                       "RANDOM",
+		RangeKeyword:                        "RANGE",
+		RankKeyword:                         "RANK",
+		ReadKeyword:                         "READ",
+		ReadOnlyKeyword:                     "READ_ONLY",
+		ReadWriteKeyword:                    "READ_WRITE",
+		ReadsKeyword:                        "READS",
+		RealKeyword:                         "REAL",
+		RebuildKeyword:                      "REBUILD",
+		RecoverKeyword:                      "RECOVER",
+		RecursiveKeyword:                    "RECURSIVE",
+		RedoBufferSizeKeyword:               "REDO_BUFFER_SIZE",
+		RedundantKeyword:                    "REDUNDANT",
+		ReferenceKeyword:                    "REFERENCE",
+		ReferencesKeyword:                   "REFERENCES",
+		RegexpKeyword:                       "REGEXP",
+		RegistrationKeyword:                 "REGISTRATION",
+		RelayKeyword:                        "RELAY",
+		RelayLogFileKeyword:                 "RELAY_LOG_FILE",
+		RelayLogPosKeyword:                  "RELAY_LOG_POS",
+		RelayThreadKeyword:                  "RELAY_THREAD",
+		RelaylogKeyword:                     "RELAYLOG",
+		ReleaseKeyword:                      "RELEASE",
+		ReloadKeyword:                       "RELOAD",
+		RemoveKeyword:                       "REMOVE",
+		RenameKeyword:                       "RENAME",
+		ReorganizeKeyword:                   "REORGANIZE",
+		RepairKeyword:                       "REPAIR",
+		RepeatKeyword:                       "REPEAT",
+		RepeatableKeyword:                   "REPEATABLE",
+		ReplaceKeyword:                      "REPLACE",
+		ReplicaKeyword:                      "REPLICA",
+		ReplicasKeyword:                     "REPLICAS",
+		ReplicateDoDbKeyword:                "REPLICATE_DO_DB",
+		ReplicateDoTableKeyword:             "REPLICATE_DO_TABLE",
+		ReplicateIgnoreDbKeyword:            "REPLICATE_IGNORE_DB",
+		ReplicateIgnoreTableKeyword:         "REPLICATE_IGNORE_TABLE",
+		ReplicateRewriteDbKeyword:           "REPLICATE_REWRITE_DB",
+		ReplicateWildDoTableKeyword:         "REPLICATE_WILD_DO_TABLE",
+		ReplicateWildIgnoreTableKeyword:     "REPLICATE_WILD_IGNORE_TABLE",
+		ReplicationKeyword:                  "REPLICATION",
+		RequireKeyword:                      "REQUIRE",
+		RequireRowFormatKeyword:             "REQUIRE_ROW_FORMAT",
+		RequireTablePrimaryKeyCheckKeyword:  "REQUIRE_TABLE_PRIMARY_KEY_CHECK",
+		ResetKeyword:                        "RESET",
+		ResignalKeyword:                     "RESIGNAL",
+		ResourceKeyword:                     "RESOURCE",
+		RespectKeyword:                      "RESPECT",
+		RestartKeyword:                      "RESTART",
+		RestoreKeyword:                      "RESTORE",
+		RestrictKeyword:                     "RESTRICT",
+		ResumeKeyword:                       "RESUME",
+		RetainKeyword:                       "RETAIN",
+		ReturnKeyword:                       "RETURN",
+		ReturnedSqlstateKeyword:             "RETURNED_SQLSTATE",
+		ReturningKeyword:                    "RETURNING",
+		ReturnsKeyword:                      "RETURNS",
+		ReuseKeyword:                        "REUSE",
+		ReverseKeyword:                      "REVERSE",
+		RevokeKeyword:                       "REVOKE",
+		RightKeyword:                        "RIGHT",
+		RlikeKeyword:                        "RLIKE",
+		RoleKeyword:                         "ROLE",
+		RollbackKeyword:                     "ROLLBACK",
+		RollupKeyword:                       "ROLLUP",
+		RotateKeyword:                       "ROTATE",
+		RoutineKeyword:                      "ROUTINE",
+		RowKeyword:                          "ROW",
+		RowCountKeyword:                     "ROW_COUNT",
+		RowFormatKeyword:                    "ROW_FORMAT",
+		RowNumberKeyword:                    "ROW_NUMBER",
+		RowsKeyword:                         "ROWS",
+		RtreeKeyword:                        "RTREE",
+		SavepointKeyword:                    "SAVEPOINT",
+		ScheduleKeyword:                     "SCHEDULE",
+		SchemaKeyword:                       "SCHEMA",
+		SchemaNameKeyword:                   "SCHEMA_NAME",
+		SchemasKeyword:                      "SCHEMAS",
+		SecondKeyword:                       "SECOND",
+		SecondMicrosecondKeyword:            "SECOND_MICROSECOND",
+		SecondaryKeyword:                    "SECONDARY",
+		SecondaryEngineKeyword:              "SECONDARY_ENGINE",
+		SecondaryEngineAttributeKeyword:     "SECONDARY_ENGINE_ATTRIBUTE",
+		SecondaryLoadKeyword:                "SECONDARY_LOAD",
+		SecondaryUnloadKeyword:              "SECONDARY_UNLOAD",
+		SecurityKeyword:                     "SECURITY",
+		Select:                              "SELECT",
+		SensitiveKeyword:                    "SENSITIVE",
+		SeparatorKeyword:                    "SEPARATOR",
+		SerialKeyword:                       "SERIAL",
+		SerializableKeyword:                 "SERIALIZABLE",
+		ServerKeyword:                       "SERVER",
+		SessionKeyword:                      "SESSION",
+		SetKeyword:                          "SET",
+		ShareKeyword:                        "SHARE",
+		ShowKeyword:                         "SHOW",
+		ShutdownKeyword:                     "SHUTDOWN",
+		SignalKeyword:                       "SIGNAL",
+		SignedKeyword:                       "SIGNED",
+		SimpleKeyword:                       "SIMPLE",
+		SkipKeyword:                         "SKIP",
+		SlaveKeyword:                        "SLAVE",
+		SlowKeyword:                         "SLOW",
+		SmallintKeyword:                     "SMALLINT",
+		SnapshotKeyword:                     "SNAPSHOT",
+		SocketKeyword:                       "SOCKET",
+		SomeKeyword:                         "SOME",
+		SonameKeyword:                       "SONAME",
+		SoundsKeyword:                       "SOUNDS",
+		SourceKeyword:                       "SOURCE",
+		SourceAutoPositionKeyword:           "SOURCE_AUTO_POSITION",
+		SourceBindKeyword:                   "SOURCE_BIND",
+		SourceCompressionAlgorithmsKeyword:  "SOURCE_COMPRESSION_ALGORITHMS",
+		SourceConnectRetryKeyword:           "SOURCE_CONNECT_RETRY",
+		SourceConnectionAutoFailoverKeyword: "SOURCE_CONNECTION_AUTO_FAILOVER",
+		SourceDelayKeyword:                  "SOURCE_DELAY",
+		SourceHeartbeatPeriodKeyword:        "SOURCE_HEARTBEAT_PERIOD",
+		SourceHostKeyword:                   "SOURCE_HOST",
+		SourceLogFileKeyword:                "SOURCE_LOG_FILE",
+		SourceLogPosKeyword:                 "SOURCE_LOG_POS",
+		SourcePasswordKeyword:               "SOURCE_PASSWORD",
+		SourcePortKeyword:                   "SOURCE_PORT",
+		SourcePublicKeyPathKeyword:          "SOURCE_PUBLIC_KEY_PATH",
+		SourceRetryCountKeyword:             "SOURCE_RETRY_COUNT",
+		SourceSslKeyword:                    "SOURCE_SSL",
+		SourceSslCaKeyword:                  "SOURCE_SSL_CA",
+		SourceSslCapathKeyword:              "SOURCE_SSL_CAPATH",
+		SourceSslCertKeyword:                "SOURCE_SSL_CERT",
+		SourceSslCipherKeyword:              "SOURCE_SSL_CIPHER",
+		SourceSslCrlKeyword:                 "SOURCE_SSL_CRL",
+		SourceSslCrlpathKeyword:             "SOURCE_SSL_CRLPATH",
+		SourceSslKeyKeyword:                 "SOURCE_SSL_KEY",
+		SourceSslVerifyServerCertKeyword:    "SOURCE_SSL_VERIFY_SERVER_CERT",
+		SourceTlsCiphersuitesKeyword:        "SOURCE_TLS_CIPHERSUITES",
+		SourceTlsVersionKeyword:             "SOURCE_TLS_VERSION",
+		SourceUserKeyword:                   "SOURCE_USER",
+		SourceZstdCompressionLevelKeyword:   "SOURCE_ZSTD_COMPRESSION_LEVEL",
+		SpatialKeyword:                      "SPATIAL",
+		SpecificKeyword:                     "SPECIFIC",
+		SqlKeyword:                          "SQL",
+		SqlAfterGtidsKeyword:                "SQL_AFTER_GTIDS",
+		SqlAfterMtsGapsKeyword:              "SQL_AFTER_MTS_GAPS",
+		SqlBeforeGtidsKeyword:               "SQL_BEFORE_GTIDS",
+		SqlBigResultKeyword:                 "SQL_BIG_RESULT",
+		SqlBufferResultKeyword:              "SQL_BUFFER_RESULT",
+		SqlCalcFoundRowsKeyword:             "SQL_CALC_FOUND_ROWS",
+		SqlNoCacheKeyword:                   "SQL_NO_CACHE",
+		SqlSmallResultKeyword:               "SQL_SMALL_RESULT",
+		SqlThreadKeyword:                    "SQL_THREAD",
+		SqlTsiDayKeyword:                    "SQL_TSI_DAY",
+		SqlTsiHourKeyword:                   "SQL_TSI_HOUR",
+		SqlTsiMinuteKeyword:                 "SQL_TSI_MINUTE",
+		SqlTsiMonthKeyword:                  "SQL_TSI_MONTH",
+		SqlTsiQuarterKeyword:                "SQL_TSI_QUARTER",
+		SqlTsiSecondKeyword:                 "SQL_TSI_SECOND",
+		SqlTsiWeekKeyword:                   "SQL_TSI_WEEK",
+		SqlTsiYearKeyword:                   "SQL_TSI_YEAR",
+		SqlexceptionKeyword:                 "SQLEXCEPTION",
+		SqlstateKeyword:                     "SQLSTATE",
+		SqlwarningKeyword:                   "SQLWARNING",
+		SridKeyword:                         "SRID",
+		SslKeyword:                          "SSL",
+		StackedKeyword:                      "STACKED",
+		StartKeyword:                        "START",
+		StartingKeyword:                     "STARTING",
+		StartsKeyword:                       "STARTS",
+		StatsAutoRecalcKeyword:              "STATS_AUTO_RECALC",
+		StatsPersistentKeyword:              "STATS_PERSISTENT",
+		StatsSamplePagesKeyword:             "STATS_SAMPLE_PAGES",
+		StatusKeyword:                       "STATUS",
+		StopKeyword:                         "STOP",
+		StorageKeyword:                      "STORAGE",
+		StoredKeyword:                       "STORED",
+		StraightJoinKeyword:                 "STRAIGHT_JOIN",
+		StreamKeyword:                       "STREAM",
+		StringKeyword:                       "STRING",
+		SubclassOriginKeyword:               "SUBCLASS_ORIGIN",
+		SubjectKeyword:                      "SUBJECT",
+		SubpartitionKeyword:                 "SUBPARTITION",
+		SubpartitionsKeyword:                "SUBPARTITIONS",
+		SuperKeyword:                        "SUPER",
+		SuspendKeyword:                      "SUSPEND",
+		SwapsKeyword:                        "SWAPS",
+		SwitchesKeyword:                     "SWITCHES",
+		SystemKeyword:                       "SYSTEM",
+		TableKeyword:                        "TABLE",
+		TableChecksumKeyword:                "TABLE_CHECKSUM",
+		TableNameKeyword:                    "TABLE_NAME",
+		TablesKeyword:                       "TABLES",
+		TablespaceKeyword:                   "TABLESPACE",
+		TemporaryKeyword:                    "TEMPORARY",
+		TemptableKeyword:                    "TEMPTABLE",
+		TerminatedKeyword:                   "TERMINATED",
+		TextKeyword:                         "TEXT",
+		ThanKeyword:                         "THAN",
+		ThenKeyword:                         "THEN",
+		ThreadPriorityKeyword:               "THREAD_PRIORITY",
+		TiesKeyword:                         "TIES",
+		TimeKeyword:                         "TIME",
+		TimestampKeyword:                    "TIMESTAMP",
+		TimestampaddKeyword:                 "TIMESTAMPADD",
+		TimestampdiffKeyword:                "TIMESTAMPDIFF",
+		TinyblobKeyword:                     "TINYBLOB",
+		TinyintKeyword:                      "TINYINT",
+		TinytextKeyword:                     "TINYTEXT",
+		TlsKeyword:                          "TLS",
+		ToKeyword:                           "TO",
+		TrailingKeyword:                     "TRAILING",
+		TransactionKeyword:                  "TRANSACTION",
+		TriggerKeyword:                      "TRIGGER",
+		TriggersKeyword:                     "TRIGGERS",
+		TrueKeyword:                         "TRUE",
+		TruncateKeyword:                     "TRUNCATE",
+		TypeKeyword:                         "TYPE",
+		TypesKeyword:                        "TYPES",
+		UnboundedKeyword:                    "UNBOUNDED",
+		UncommittedKeyword:                  "UNCOMMITTED",
+		UndefinedKeyword:                    "UNDEFINED",
+		UndoKeyword:                         "UNDO",
+		UndoBufferSizeKeyword:               "UNDO_BUFFER_SIZE",
+		UndofileKeyword:                     "UNDOFILE",
+		UnicodeKeyword:                      "UNICODE",
+		UninstallKeyword:                    "UNINSTALL",
+		UnionKeyword:                        "UNION",
+		UniqueKeyword:                       "UNIQUE",
+		UnknownKeyword:                      "UNKNOWN",
+		UnlockKeyword:                       "UNLOCK",
+		UnregisterKeyword:                   "UNREGISTER",
+		UnsignedKeyword:                     "UNSIGNED",
+		UntilKeyword:                        "UNTIL",
+		UpdateKeyword:                       "UPDATE",
+		UpgradeKeyword:                      "UPGRADE",
+		UsageKeyword:                        "USAGE",
+		UseKeyword:                          "USE",
+		UseFrmKeyword:                       "USE_FRM",
+		UserKeyword:                         "USER",
+		UserResourcesKeyword:                "USER_RESOURCES",
+		UsingKeyword:                        "USING",
+		UtcDateKeyword:                      "UTC_DATE",
+		UtcTimeKeyword:                      "UTC_TIME",
+		UtcTimestampKeyword:                 "UTC_TIMESTAMP",
+		ValidationKeyword:                   "VALIDATION",
+		ValueKeyword:                        "VALUE",
+		ValuesKeyword:                       "VALUES",
+		VarbinaryKeyword:                    "VARBINARY",
+		VarcharKeyword:                      "VARCHAR",
+		VarcharacterKeyword:                 "VARCHARACTER",
+		VariablesKeyword:                    "VARIABLES",
+		VaryingKeyword:                      "VARYING",
+		VcpuKeyword:                         "VCPU",
+		ViewKeyword:                         "VIEW",
+		VirtualKeyword:                      "VIRTUAL",
+		VisibleKeyword:                      "VISIBLE",
+		WaitKeyword:                         "WAIT",
+		WarningsKeyword:                     "WARNINGS",
+		WeekKeyword:                         "WEEK",
+		WeightStringKeyword:                 "WEIGHT_STRING",
+		WhenKeyword:                         "WHEN",
+		Where:                               "WHERE",
+		WhileKeyword:                        "WHILE",
+		WindowKeyword:                       "WINDOW",
+		WithKeyword:                         "WITH",
+		WithoutKeyword:                      "WITHOUT",
+		WorkKeyword:                         "WORK",
+		WrapperKeyword:                      "WRAPPER",
+		WriteKeyword:                        "WRITE",
+		X509Keyword:                         "X509",
+		XaKeyword:                           "XA",
+		XidKeyword:                          "XID",
+		XmlKeyword:                          "XML",
+		XorKeyword:                          "XOR",
+		YearKeyword:                         "YEAR",
+		YearMonthKeyword:                    "YEAR_MONTH",
+		ZerofillKeyword:                     "ZEROFILL",
+		ZoneKeyword:                         "ZONE",
+	}
+)
@@ -0,0 +1,122 @@
+package token
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/romberli/go-util/constant"
+)
+
+const (
+	firstLine   = 1
+	firstColumn = 1
+	newLineRune = '\n'
+)
+
+// Position represents a location in the input text,
+// offset and rune offset are 0-based, line and column are 1-based and column is counted by runes
+type Position struct {
+	Offset     int
+	RuneOffset int
+	Line       int
+	Column     int
+}
+
+// NewPosition returns a new Position
+func NewPosition(offset, runeOffset, line, column int) Position {
+	return Position{
+		Offset:     offset,
+		RuneOffset: runeOffset,
+		Line:       line,
+		Column:     column,
+	}
+}
+
+// NewPositionWithDefault returns the position of the beginning of the input text
+func NewPositionWithDefault() Position {
+	return NewPosition(constant.ZeroInt, constant.ZeroInt, firstLine, firstColumn)
+}
+
+// IsValid returns if the position is a valid position, zero value position is not valid
+func (p Position) IsValid() bool {
+	return p.Line > constant.ZeroInt
+}
+
+// Advance returns the position after the given rune
+func (p Position) Advance(c rune) Position {
+	return p.AdvanceBytes(c, utf8.RuneLen(c))
+}
+
+// AdvanceBytes returns the position after the given rune which occupies size bytes in the input,
+// it is useful when the rune is decoded from invalid utf-8 bytes
+func (p Position) AdvanceBytes(c rune, size int) Position {
+	p.Offset += size
+	p.RuneOffset++
+	if c == newLineRune {
+		p.Line++
+		p.Column = firstColumn
+		return p
+	}
+
+	p.Column++
+
+	return p
+}
+
+// AdvanceString returns the position after the given string
+func (p Position) AdvanceString(s string) Position {
+	for _, c := range s {
+		p = p.Advance(c)
+	}
+
+	return p
+}
+
+// String returns the string representation of the position
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span represents a range of the input text, start is inclusive and end is exclusive
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// NewSpan returns a new Span
+func NewSpan(start, end Position) Span {
+	return Span{
+		Start: start,
+		End:   end,
+	}
+}
+
+// IsValid returns if the span is a valid span
+func (s Span) IsValid() bool {
+	return s.Start.IsValid() && s.End.IsValid()
+}
+
+// Merge returns the smallest span that covers both spans,
+// invalid span will be ignored
+func (s Span) Merge(other Span) Span {
+	if !s.IsValid() {
+		return other
+	}
+	if !other.IsValid() {
+		return s
+	}
+
+	if other.Start.Offset < s.Start.Offset {
+		s.Start = other.Start
+	}
+	if other.End.Offset > s.End.Offset {
+		s.End = other.End
+	}
+
+	return s
+}
+
+// String returns the string representation of the span
+func (s Span) String() string {
+	return fmt.Sprintf("%s-%s", s.Start.String(), s.End.String())
+}
@@ -0,0 +1,308 @@
+package token
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/pingcap/errors"
+	"github.com/romberli/go-util/constant"
+)
+
+// Category is the category of the token type, a token type may belong to several categories, e.g. DIV is a keyword and an operator
+type Category uint
+
+const (
+	// KeywordCategory means the token type is a keyword, keywords are matched case-insensitively and have the top priority
+	KeywordCategory Category = 1 << iota
+	// OperatorCategory means the token type is an operator
+	OperatorCategory
+)
+
+// Has returns if the category contains the given category
+func (c Category) Has(other Category) bool {
+	return c&other == other
+}
+
+// String returns the string representation of the category
+func (c Category) String() string {
+	var categories []string
+	if c.Has(KeywordCategory) {
+		categories = append(categories, "keyword")
+	}
+	if c.Has(OperatorCategory) {
+		categories = append(categories, "operator")
+	}
+	if len(categories) == constant.ZeroInt {
+		return "other"
+	}
+
+	return strings.Join(categories, "|")
+}
+
+// builtInNames are the names of the built-in token types which are not keywords, the names of the keywords are generated
+var builtInNames = map[Type]string{
+	Identifier:            "identifier",
+	QuotedIdentifier:      "quotedIdentifier",
+	GE:                    "greaterOrEqual",
+	GT:                    "greaterThan",
+	LE:                    "lessOrEqual",
+	LT:                    "lessThan",
+	Equal:                 "equal",
+	NotEqual1:             "notEqual",
+	NotEqual2:             "notEqual",
+	NullSafeEqual:         "nullSafeEqual",
+	LogicalOr:             "logicalOr",
+	LogicalAnd:            "logicalAnd",
+	LogicalNot:            "logicalNot",
+	BitOr:                 "bitOr",
+	BitAnd:                "bitAnd",
+	BitXor:                "bitXor",
+	BitNot:                "bitNot",
+	LeftShift:             "leftShift",
+	RightShift:            "rightShift",
+	Assign:                "assign",
+	JSONExtract:           "jsonExtract",
+	JSONUnquoteExtract:    "jsonUnquoteExtract",
+	Plus:                  "plus",
+	Minus:                 "minus",
+	Multiply:              "multiply",
+	Divide:                "divide",
+	Mod:                   "mod",
+	NumberLiteral:         "numberLiteral",
+	DecimalLiteral:        "decimalLiteral",
+	FloatLiteral:          "floatLiteral",
+	HexLiteral:            "hexLiteral",
+	BitLiteral:            "bitLiteral",
+	StringLiteral:         "stringLiteral",
+	PositionalPlaceholder: "positionalPlaceholder",
+	NamedPlaceholder:      "namedPlaceholder",
+	UserVariable:          "userVariable",
+	SystemVariable:        "systemVariable",
+	Comma:                 "comma",
+	Semicolon:             "semicolon",
+	LeftParenthesis:       "leftParenthesis",
+	RightParenthesis:      "rightParenthesis",
+	SingleQuote:           "singleQuote",
+	Dot:                   "dot",
+	At:                    "at",
+	Comment:               "comment",
+	WhiteSpace:            "whiteSpace",
+	Epsilon:               "ε",
+	End:                   "end",
+	Error:                 "error",
+	HintBegin:             "hintBegin",
+	HintEnd:               "hintEnd",
+}
+
+// builtInOperators are the built-in token types which are operators, DIV and MOD are the keywords which are also operators
+var builtInOperators = []Type{
+	GE, GT, LE, LT, Equal, NotEqual1, NotEqual2, NullSafeEqual,
+	LogicalOr, LogicalAnd, LogicalNot,
+	BitOr, BitAnd, BitXor, BitNot, LeftShift, RightShift,
+	Assign, JSONExtract, JSONUnquoteExtract,
+	Plus, Minus, Multiply, Divide, Mod, DivKeyword, ModKeyword,
+}
+
+// TypeInfo describes a token type
+type TypeInfo struct {
+	Type Type
+	// Name is the string representation of the token type
+	Name     string
+	Category Category
+	// Text is the upper case word of the keyword or the text of the operator,
+	// the lexers which are built with the default rules recognize the registered token types by the text,
+	// it is empty if the token type is recognized by a pattern
+	Text     string
+	Reserved bool
+}
+
+// NewTypeInfo returns a new *TypeInfo
+func NewTypeInfo(t Type, name string, category Category, text string, reserved bool) *TypeInfo {
+	return &TypeInfo{
+		Type:     t,
+		Name:     name,
+		Category: category,
+		Text:     text,
+		Reserved: reserved,
+	}
+}
+
+// registry contains the information of all the token types,
+// the built-in token types and the generated keywords are registered when the package is initialized,
+// the other token types are registered at runtime and their values are allocated after the existing token types
+type registry struct {
+	mutex sync.RWMutex
+	// infos is indexed by the token type, the index 0 is not a valid token type
+	infos []*TypeInfo
+	// keywords maps the upper case word to the token type
+	keywords map[string]Type
+	// lastBuiltIn is the last token type which is not registered at runtime
+	lastBuiltIn Type
+}
+
+var defaultRegistry = newRegistry()
+
+// newRegistry returns a new *registry which contains the built-in token types and the generated keywords
+func newRegistry() *registry {
+	r := &registry{
+		keywords: make(map[string]Type),
+	}
+
+	for t, name := range builtInNames {
+		r.set(NewTypeInfo(t, name, constant.ZeroInt, constant.EmptyString, false))
+	}
+	for word, kw := range keywordMap {
+		r.set(NewTypeInfo(kw.tokenType, getKeywordName(word), KeywordCategory, word, kw.reserved))
+		r.keywords[word] = kw.tokenType
+	}
+	for _, t := range builtInOperators {
+		r.infos[t].Category |= OperatorCategory
+	}
+	r.lastBuiltIn = Type(len(r.infos) - 1)
+
+	return r
+}
+
+// set sets the information of the token type, the infos will be extended if necessary
+func (r *registry) set(info *TypeInfo) {
+	for int(info.Type) >= len(r.infos) {
+		r.infos = append(r.infos, nil)
+	}
+	r.infos[info.Type] = info
+}
+
+// get returns the information of the token type, it returns nil if the token type is not registered
+func (r *registry) get(t Type) *TypeInfo {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if t <= constant.ZeroInt || int(t) >= len(r.infos) {
+		return nil
+	}
+
+	return r.infos[t]
+}
+
+// register registers a new token type
+func (r *registry) register(name string, category Category, text string, reserved bool) (Type, error) {
+	if strings.TrimSpace(name) == constant.EmptyString {
+		return Error, errors.New("name of the token type must not be empty")
+	}
+	if category.Has(KeywordCategory) && text != constant.EmptyString {
+		if strings.IndexFunc(text, unicode.IsSpace) >= constant.ZeroInt {
+			return Error, errors.Errorf("keyword must not contain white spaces. keyword: %s", text)
+		}
+		text = strings.ToUpper(text)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, info := range r.infos {
+		if info != nil && strings.EqualFold(info.Name, name) {
+			return Error, errors.Errorf("token type name is already registered. name: %s, token type: %d", name, info.Type)
+		}
+	}
+	if category.Has(KeywordCategory) {
+		t, ok := r.keywords[text]
+		if ok {
+			return Error, errors.Errorf("keyword is already registered. keyword: %s, token type: %d", text, t)
+		}
+	}
+
+	t := Type(len(r.infos))
+	r.set(NewTypeInfo(t, name, category, text, reserved))
+	if category.Has(KeywordCategory) && text != constant.EmptyString {
+		r.keywords[text] = t
+	}
+
+	return t, nil
+}
+
+// unregister unregisters the token type which is registered at runtime,
+// the values of the trailing unregistered token types are allocated again by the next registering
+func (r *registry) unregister(t Type) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if t <= r.lastBuiltIn || int(t) >= len(r.infos) || r.infos[t] == nil {
+		return errors.Errorf("token type is not registered at runtime. token type: %d", t)
+	}
+	info := r.infos[t]
+	if info.Category.Has(KeywordCategory) && r.keywords[info.Text] == t {
+		delete(r.keywords, info.Text)
+	}
+	r.infos[t] = nil
+	for r.infos[len(r.infos)-1] == nil {
+		r.infos = r.infos[:len(r.infos)-1]
+	}
+
+	return nil
+}
+
+// RegisterType registers a new token type with the given name and category and returns the allocated token type,
+// the text is the keyword or the operator which the lexers recognize, it could be empty if the token type is recognized by a rule of the lexer,
+// the reserved only takes effect if the token type is a keyword, the built-in token types always keep their values,
+// the token types should be registered at init time before the lexers are built
+func RegisterType(name string, category Category, text string, reserved bool) (Type, error) {
+	return defaultRegistry.register(name, category, text, reserved)
+}
+
+// RegisterKeyword registers a new keyword, the name of the token type is the lower case word followed by Keyword, e.g. hintKeyword
+func RegisterKeyword(word string, reserved bool) (Type, error) {
+	if word == constant.EmptyString {
+		return Error, errors.New("keyword must not be empty")
+	}
+
+	return RegisterType(getKeywordName(word), KeywordCategory, word, reserved)
+}
+
+// RegisterOperator registers a new operator which is recognized by the given text
+func RegisterOperator(name, text string) (Type, error) {
+	if text == constant.EmptyString {
+		return Error, errors.New("operator must not be empty")
+	}
+
+	return RegisterType(name, OperatorCategory, text, false)
+}
+
+// UnregisterType unregisters the token type which is registered at runtime, the built-in token types could not be unregistered,
+// the lexers which have been built still recognize the token type
+func UnregisterType(t Type) error {
+	return defaultRegistry.unregister(t)
+}
+
+// GetTypeInfo returns the information of the token type, it returns false if the token type is not registered
+func GetTypeInfo(t Type) (*TypeInfo, bool) {
+	info := defaultRegistry.get(t)
+	if info == nil {
+		return nil, false
+	}
+	copied := *info
+
+	return &copied, true
+}
+
+// GetRegisteredTypeInfos returns the information of the token types which are registered at runtime in ascending order of the token types
+func GetRegisteredTypeInfos() []*TypeInfo {
+	r := defaultRegistry
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var infos []*TypeInfo
+	for _, info := range r.infos[r.lastBuiltIn+1:] {
+		if info == nil {
+			continue
+		}
+		copied := *info
+		infos = append(infos, &copied)
+	}
+
+	return infos
+}
+
+// getKeywordName returns the name of the keyword token type, e.g. SELECT -> selectKeyword
+func getKeywordName(word string) string {
+	return strings.ToLower(word) + "Keyword"
+}
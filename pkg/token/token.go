@@ -2,6 +2,7 @@ package token
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/romberli/go-util/constant"
 )
@@ -18,6 +19,7 @@ const (
 	Or
 	// identifier
 	Identifier
+	QuotedIdentifier
 	// comparison operator
 	GE
 	GT
@@ -26,6 +28,23 @@ const (
 	Equal
 	NotEqual1
 	NotEqual2
+	NullSafeEqual
+	// logical operator
+	LogicalOr
+	LogicalAnd
+	LogicalNot
+	// bit operator
+	BitOr
+	BitAnd
+	BitXor
+	BitNot
+	LeftShift
+	RightShift
+	// assignment operator
+	Assign
+	// json operator
+	JSONExtract
+	JSONUnquoteExtract
 	// arithmetic operator
 	Plus
 	Minus
@@ -34,14 +53,28 @@ const (
 	Mod
 	// number literal
 	NumberLiteral
+	DecimalLiteral
+	FloatLiteral
+	HexLiteral
+	BitLiteral
 	// string literal
 	StringLiteral
+	// placeholder
+	PositionalPlaceholder
+	NamedPlaceholder
+	// variable
+	UserVariable
+	SystemVariable
 	// separator
 	Comma
 	Semicolon
 	LeftParenthesis
 	RightParenthesis
 	SingleQuote
+	Dot
+	At
+	// comment
+	Comment
 	// white space
 	WhiteSpace
 	// Epsilon
@@ -52,92 +85,70 @@ const (
 	Error
 )
 
+// the built-in token types which are added after the keywords, so that the existing token types keep their values
+const (
+	// optimizer hint
+	HintBegin Type = keywordEnd + iota
+	HintEnd
+)
+
 var (
 	// epsilon
 	EpsilonRune rune = constant.ZeroInt
-	KeywordList      = []Type{Select, From, As, Where, And, Or}
 )
 
-// String returns the string representation of the token type
+// String returns the string representation of the token type, it is the name which is registered in the registry
 func (t Type) String() string {
-	switch t {
-	case Select:
-		return "selectKeyword"
-	case From:
-		return "fromKeyword"
-	case As:
-		return "asKeyword"
-	case And:
-		return "andKeyword"
-	case Or:
-		return "orKeyword"
-	case Where:
-		return "whereKeyword"
-	case Identifier:
-		return "identifier"
-	case GE:
-		return "greaterOrEqual"
-	case GT:
-		return "greaterThan"
-	case LE:
-		return "lessOrEqual"
-	case LT:
-		return "lessThan"
-	case Equal:
-		return "equal"
-	case NotEqual1, NotEqual2:
-		return "notEqual"
-	case Plus:
-		return "plus"
-	case Minus:
-		return "minus"
-	case Multiply:
-		return "multiply"
-	case Divide:
-		return "divide"
-	case Mod:
-		return "mod"
-	case NumberLiteral:
-		return "numberLiteral"
-	case StringLiteral:
-		return "stringLiteral"
-	case LeftParenthesis:
-		return "leftParenthesis"
-	case RightParenthesis:
-		return "rightParenthesis"
-	case Comma:
-		return "comma"
-	case Semicolon:
-		return "semicolon"
-	case SingleQuote:
-		return "singleQuote"
-	case WhiteSpace:
-		return "whiteSpace"
-	case Epsilon:
-		return "ε"
-	case End:
-		return "end"
-	case Error:
-		return "error"
-	default:
+	info := defaultRegistry.get(t)
+	if info == nil {
 		return "unknown"
 	}
+
+	return info.Name
 }
 
-// IsKeyword returns if the token type is a keyword
-func (t Type) IsKeyword() bool {
-	for _, keyword := range KeywordList {
-		if t == keyword {
-			return true
-		}
+// IsNumberLiteral returns if the token type is a numeric literal, including the hexadecimal and bit-value literals
+func (t Type) IsNumberLiteral() bool {
+	switch t {
+	case NumberLiteral, DecimalLiteral, FloatLiteral, HexLiteral, BitLiteral:
+		return true
+	default:
+		return false
 	}
+}
+
+// IsOperator returns if the token type is an operator, DIV and MOD are the keywords which are also operators
+func (t Type) IsOperator() bool {
+	info := defaultRegistry.get(t)
+
+	return info != nil && info.Category.Has(OperatorCategory)
+}
 
-	return false
+// IsPlaceholder returns if the token type is a placeholder of the prepared statement, e.g. ?, $1 or :name
+func (t Type) IsPlaceholder() bool {
+	return t == PositionalPlaceholder || t == NamedPlaceholder
+}
+
+// IsVariable returns if the token type is a user variable or a system variable
+func (t Type) IsVariable() bool {
+	return t == UserVariable || t == SystemVariable
+}
+
+// IsTrivia returns if the token type is a trivia, trivia are the white spaces and the comments,
+// they do not affect the meaning of the sql but are needed to reproduce the input text
+func (t Type) IsTrivia() bool {
+	return t == WhiteSpace || t == Comment
 }
 
 type Token struct {
 	Type   Type
 	Lexeme string
+	Value  interface{}
+	Span   Span
+	// LeadingTrivia are the trivia before the token which are not the trailing trivia of the previous token
+	LeadingTrivia []*Token
+	// TrailingTrivia are the trivia after the token until the end of the line
+	TrailingTrivia []*Token
 }
 
 // NewToken returns a new *Token
@@ -148,7 +159,108 @@ func NewToken(tokenType Type, lexeme string) *Token {
 	}
 }
 
+// NewTokenWithSpan returns a new *Token with the position of the lexeme in the input text
+func NewTokenWithSpan(tokenType Type, lexeme string, span Span) *Token {
+	return &Token{
+		Type:   tokenType,
+		Lexeme: lexeme,
+		Span:   span,
+	}
+}
+
+// GetValue returns the value of the token, e.g. the unescaped string of a string literal,
+// it returns nil if the token has no value other than the lexeme
+func (t *Token) GetValue() interface{} {
+	return t.Value
+}
+
+// SetValue sets the value of the token
+func (t *Token) SetValue(value interface{}) {
+	t.Value = value
+}
+
+// GetSpan returns the span of the token
+func (t *Token) GetSpan() Span {
+	return t.Span
+}
+
+// SetSpan sets the span of the token
+func (t *Token) SetSpan(span Span) {
+	t.Span = span
+}
+
+// GetLeadingTrivia returns the leading trivia of the token
+func (t *Token) GetLeadingTrivia() []*Token {
+	return t.LeadingTrivia
+}
+
+// GetTrailingTrivia returns the trailing trivia of the token
+func (t *Token) GetTrailingTrivia() []*Token {
+	return t.TrailingTrivia
+}
+
+// GetFullLexeme returns the lexeme of the token with the leading and trailing trivia
+func (t *Token) GetFullLexeme() string {
+	var builder strings.Builder
+
+	for _, trivia := range t.LeadingTrivia {
+		builder.WriteString(trivia.Lexeme)
+	}
+	builder.WriteString(t.Lexeme)
+	for _, trivia := range t.TrailingTrivia {
+		builder.WriteString(trivia.Lexeme)
+	}
+
+	return builder.String()
+}
+
+// GetFullSpan returns the span of the token with the leading and trailing trivia
+func (t *Token) GetFullSpan() Span {
+	span := t.Span
+	for _, trivia := range t.LeadingTrivia {
+		span = span.Merge(trivia.Span)
+	}
+	for _, trivia := range t.TrailingTrivia {
+		span = span.Merge(trivia.Span)
+	}
+
+	return span
+}
+
+// GetNormalizedLexeme returns the normalized form of the lexeme,
+// keywords are case-insensitive, so they are normalized to upper case, other lexemes are returned as they are
+func (t *Token) GetNormalizedLexeme() string {
+	if t.Type.IsKeyword() {
+		return strings.ToUpper(t.Lexeme)
+	}
+
+	return t.Lexeme
+}
+
 // String returns the string representation of the token
 func (t *Token) String() string {
-	return fmt.Sprintf(`{tokenType: %s, lexeme: %s}`, t.Type.String(), t.Lexeme)
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf(`{tokenType: %s, lexeme: %s`, t.Type.String(), t.Lexeme))
+	if t.Value != nil {
+		builder.WriteString(fmt.Sprintf(`, value: %v`, t.Value))
+	}
+	if t.Span.IsValid() {
+		builder.WriteString(fmt.Sprintf(`, span: %s`, t.Span.String()))
+	}
+	builder.WriteString(`}`)
+
+	return builder.String()
+}
+
+// GetFullText returns the concatenation of the full lexemes of the tokens,
+// if the tokens are lexed losslessly, it is exactly the input text
+func GetFullText(tokens []*Token) string {
+	var builder strings.Builder
+
+	for _, t := range tokens {
+		builder.WriteString(t.GetFullLexeme())
+	}
+
+	return builder.String()
 }
@@ -1,6 +1,8 @@
 package token
 
 import (
+	"strings"
+
 	"github.com/romberli/go-util/constant"
 )
 
@@ -38,3 +40,20 @@ func hasIntersect(first, second []Type) bool {
 
 	return false
 }
+
+// LookupTypes returns the token types of which string representation is the given name, the name is case-insensitive,
+// several token types may have the same name, e.g. notEqual, it returns nil if there is no such token type
+func LookupTypes(name string) []Type {
+	r := defaultRegistry
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var tokenTypes []Type
+	for _, info := range r.infos {
+		if info != nil && strings.EqualFold(info.Name, name) {
+			tokenTypes = append(tokenTypes, info.Type)
+		}
+	}
+
+	return tokenTypes
+}
@@ -0,0 +1,70 @@
+package token
+
+import (
+	"fmt"
+	"strings"
+)
+
+type VariableScope int
+
+const (
+	// DefaultScope means the scope is not specified, e.g. @@autocommit
+	DefaultScope VariableScope = iota
+	GlobalScope
+	// SessionScope is also used for the local scope, as local is a synonym for session
+	SessionScope
+	PersistScope
+	PersistOnlyScope
+)
+
+var scopeMap = map[string]VariableScope{
+	"GLOBAL":       GlobalScope,
+	"SESSION":      SessionScope,
+	"LOCAL":        SessionScope,
+	"PERSIST":      PersistScope,
+	"PERSIST_ONLY": PersistOnlyScope,
+}
+
+// LookupVariableScope returns the scope of the given word case-insensitively, it returns false if the word is not a scope
+func LookupVariableScope(word string) (VariableScope, bool) {
+	scope, ok := scopeMap[strings.ToUpper(word)]
+
+	return scope, ok
+}
+
+// String returns the string representation of the scope
+func (vs VariableScope) String() string {
+	switch vs {
+	case DefaultScope:
+		return "default"
+	case GlobalScope:
+		return "global"
+	case SessionScope:
+		return "session"
+	case PersistScope:
+		return "persist"
+	case PersistOnlyScope:
+		return "persist_only"
+	default:
+		return "unknown"
+	}
+}
+
+// ScopedVariable is the value of the system variable token, e.g. @@global.max_connections
+type ScopedVariable struct {
+	Scope VariableScope
+	Name  string
+}
+
+// NewScopedVariable returns a new *ScopedVariable
+func NewScopedVariable(scope VariableScope, name string) *ScopedVariable {
+	return &ScopedVariable{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+// String returns the string representation of the system variable
+func (sv *ScopedVariable) String() string {
+	return fmt.Sprintf("{scope: %s, name: %s}", sv.Scope.String(), sv.Name)
+}
@@ -0,0 +1,198 @@
+// keyword generates the keyword token types and the keyword strings of the lexer from keywords.txt,
+// run "go generate ./pkg/token" after modifying keywords.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	reservedMark  = "(R)"
+	commentPrefix = "#"
+	header        = "// Code generated by tools/keyword from tools/keyword/keywords.txt; DO NOT EDIT.\n\n"
+)
+
+// builtInKeywords are the keywords which are defined in token.go,
+// they keep the names and the numeric values which are defined there
+var builtInKeywords = map[string]string{
+	"SELECT": "Select",
+	"FROM":   "From",
+	"AS":     "As",
+	"WHERE":  "Where",
+	"AND":    "And",
+	"OR":     "Or",
+}
+
+type keyword struct {
+	word     string
+	name     string
+	reserved bool
+}
+
+func main() {
+	input := flag.String("input", "../../tools/keyword/keywords.txt", "the keyword list file")
+	tokenOutput := flag.String("token", "keywords.go", "the output file of package token")
+	lexerOutput := flag.String("lexer", "../lexer/keywords.go", "the output file of package lexer")
+	flag.Parse()
+
+	keywords, err := readKeywords(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = writeSource(*tokenOutput, getTokenSource(keywords))
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = writeSource(*lexerOutput, getLexerSource(keywords))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readKeywords reads the keywords from the given file, each line contains a keyword,
+// the reserved keyword is followed by (R)
+func readKeywords(fileName string) ([]*keyword, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var keywords []*keyword
+	names := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) > 2 || len(fields) == 2 && fields[1] != reservedMark {
+			return nil, fmt.Errorf("invalid line: %s", line)
+		}
+
+		word := strings.ToUpper(fields[0])
+		name := getName(word)
+		other, ok := names[name]
+		if ok {
+			return nil, fmt.Errorf("keywords %s and %s have the same name %s", other, word, name)
+		}
+		names[name] = word
+
+		keywords = append(keywords, &keyword{
+			word:     word,
+			name:     name,
+			reserved: len(fields) == 2,
+		})
+	}
+
+	return keywords, scanner.Err()
+}
+
+// getName returns the name of the token type constant of the keyword,
+// e.g. CURRENT_USER -> CurrentUserKeyword
+func getName(word string) string {
+	name, ok := builtInKeywords[word]
+	if ok {
+		return name
+	}
+
+	var builder strings.Builder
+	for _, part := range strings.Split(strings.ToLower(word), "_") {
+		if part == "" {
+			continue
+		}
+		builder.WriteString(strings.ToUpper(part[:1]))
+		builder.WriteString(part[1:])
+	}
+	builder.WriteString("Keyword")
+
+	return builder.String()
+}
+
+// getTokenSource returns the source code of package token
+func getTokenSource(keywords []*keyword) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(header)
+	buf.WriteString("package token\n\n")
+
+	buf.WriteString("// the keyword token types are appended after Error, so they shift if a token type is inserted before Error\n")
+	buf.WriteString("// or a keyword is inserted before them in keywords.txt, the built-in token types which are added later are appended after keywordEnd\n")
+	buf.WriteString("const (\n")
+	first := true
+	for _, kw := range keywords {
+		if _, ok := builtInKeywords[kw.word]; ok {
+			continue
+		}
+		if first {
+			fmt.Fprintf(&buf, "%s Type = Error + 1 + iota\n", kw.name)
+			first = false
+			continue
+		}
+		fmt.Fprintf(&buf, "%s\n", kw.name)
+	}
+	buf.WriteString("// keywordEnd is the token type after the last keyword\n")
+	buf.WriteString("keywordEnd\n")
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("var (\n")
+	buf.WriteString("// KeywordList contains all the keyword token types\n")
+	buf.WriteString("KeywordList = []Type{\n")
+	for _, kw := range keywords {
+		fmt.Fprintf(&buf, "%s,\n", kw.name)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString("// keywordMap is the map of the upper case keyword and its attributes\n")
+	buf.WriteString("keywordMap = map[string]keyword{\n")
+	for _, kw := range keywords {
+		fmt.Fprintf(&buf, "%q: {%s, %t},\n", kw.word, kw.name, kw.reserved)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString("// keywordStringMap is the map of the keyword token type and the upper case keyword\n")
+	buf.WriteString("keywordStringMap = map[Type]string{\n")
+	for _, kw := range keywords {
+		fmt.Fprintf(&buf, "%s: %q,\n", kw.name, kw.word)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString(")\n")
+
+	return buf.Bytes()
+}
+
+// getLexerSource returns the source code of package lexer
+func getLexerSource(keywords []*keyword) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(header)
+	buf.WriteString("package lexer\n\n")
+	buf.WriteString("import \"github.com/romberli/sql-parser-go/pkg/token\"\n\n")
+	buf.WriteString("// KeywordMap is the map of the keyword token type and the lower case keyword, keywords are matched case-insensitively\n")
+	buf.WriteString("var KeywordMap = map[token.Type]string{\n")
+	for _, kw := range keywords {
+		fmt.Fprintf(&buf, "token.%s: %q,\n", kw.name, strings.ToLower(kw.word))
+	}
+	buf.WriteString("}\n")
+
+	return buf.Bytes()
+}
+
+// writeSource formats the source code and writes it to the given file
+func writeSource(fileName string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}